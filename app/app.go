@@ -99,6 +99,7 @@ var (
 		earning.ModuleName:              nil,
 		earning.VpnCollectorName:        nil,
 		earning.StorageCollectorName:    nil,
+		votingTypes.ModuleName:          {authTypes.Burner},
 	}
 )
 
@@ -216,6 +217,7 @@ func NewArteryApp(
 			earningTypes.VpnCollectorName:     {},
 			earningTypes.StorageCollectorName: {},
 			earningTypes.ModuleName:           {},
+			votingTypes.ModuleName:            {authTypes.Burner},
 		},
 	)
 
@@ -280,6 +282,7 @@ func NewArteryApp(
 		app.referralKeeper,
 		app.accountKeeper,
 		app.bankKeeper,
+		app.scheduleKeeper,
 		app.subspaces[noding.DefaultParamspace],
 		authTypes.FeeCollectorName,
 		util.SplittableFeeCollectorName,
@@ -306,6 +309,7 @@ func NewArteryApp(
 		app.profileKeeper,
 		app.earningKeeper,
 		app.bankKeeper,
+		app.subspaces,
 	)
 
 	app.referralKeeper.SetKeepers(app.nodingKeeper)
@@ -320,6 +324,8 @@ func NewArteryApp(
 			return nil
 		})
 
+	app.bankKeeper.AddHook("BeforeSetCoins", "snapshot-poll-weights", app.votingKeeper.OnBeforeBalanceChanged)
+
 	app.scheduleKeeper.AddHook(referral.StatusDowngradeHookName, app.referralKeeper.PerformDowngrade)
 	app.scheduleKeeper.AddHook(referral.CompressionHookName, app.referralKeeper.PerformCompression)
 	app.scheduleKeeper.AddHook(referral.TransitionTimeoutHookName, app.referralKeeper.PerformTransitionTimeout)
@@ -327,9 +333,12 @@ func NewArteryApp(
 	app.scheduleKeeper.AddHook(profileTypes.RefreshImHookName, app.profileKeeper.HandleRenewImHook)
 	app.scheduleKeeper.AddHook(votingTypes.VoteHookName, app.votingKeeper.ProcessSchedule)
 	app.scheduleKeeper.AddHook(votingTypes.PollHookName, app.votingKeeper.EndPollHandler)
+	app.scheduleKeeper.AddHook(votingTypes.ActivateHookName, app.votingKeeper.ActivateHandler)
 	app.scheduleKeeper.AddHook(delegating.RevokeHookName, app.delegatingKeeper.MustPerformRevoking)
 	app.scheduleKeeper.AddHook(delegating.AccrueHookName, app.delegatingKeeper.MustPerformAccrue)
 	app.scheduleKeeper.AddHook(referral.BanishHookName, app.referralKeeper.PerformBanish)
+	app.scheduleKeeper.AddHook(nodingTypes.ResumeHookName, app.nodingKeeper.PerformResume)
+	app.scheduleKeeper.AddHook(nodingTypes.StaffExpiryHookName, app.nodingKeeper.PerformStaffExpiry)
 
 	app.referralKeeper.AddHook(referral.StatusUpdatedCallback, app.nodingKeeper.OnStatusUpdate)
 	app.referralKeeper.AddHook(referral.StakeChangedCallback, app.nodingKeeper.OnStakeChanged)
@@ -445,6 +454,18 @@ func NewArteryApp(
 		AddMissingProfileRefreshTask(app.scheduleKeeper, keys[profileTypes.StoreKey], ec.Marshaler),
 	))
 
+	nodingDefaultParams := nodingTypes.DefaultParams()
+	votingDefaultParams := votingTypes.DefaultParams()
+	profileDefaultParams := profileTypes.DefaultParams()
+	app.upgradeKeeper.SetUpgradeHandler("2.6.0", Chain(
+		InitMissingParams(app.subspaces[noding.DefaultParamspace], &nodingDefaultParams),
+		PruneProposerIndex(app.nodingKeeper),
+		MigrateVotingProposals(app.votingKeeper),
+		MigrateVotingPolls(app.votingKeeper),
+		InitMissingParams(app.subspaces[votingTypes.ModuleName], &votingDefaultParams),
+		InitMissingParams(app.subspaces[profileTypes.DefaultParamspace], profileDefaultParams),
+	))
+
 	// NOTE: Any module instantiated in the module manager that is later modified
 	// must be passed by reference here.
 	app.mm = module.NewManager(
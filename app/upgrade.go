@@ -1131,3 +1131,55 @@ func AddMissingProfileRefreshTask(sk scheduleK.Keeper, pKey sdk.StoreKey, cdc co
 		logger.Info("... AddMissingProfileRefreshTask done!")
 	}
 }
+
+// InitMissingParams sets all the params that are absent from a subspace (i.e. have been introduced by the upgrade)
+// to their default values. Params that already exist are left intact.
+func InitMissingParams(paramspace params.Subspace, defaults params.ParamSet) upgrade.UpgradeHandler {
+	return func(ctx sdk.Context, _ upgrade.Plan) {
+		logger := ctx.Logger().With("module", "x/upgrade")
+		logger.Info("Starting InitMissingParams ...", "subspace", paramspace.Name())
+
+		for _, pair := range defaults.ParamSetPairs() {
+			if paramspace.Has(ctx, pair.Key) {
+				continue
+			}
+			logger.Info("... init param", "key", string(pair.Key), "value", pair.Value)
+			paramspace.Set(ctx, pair.Key, pair.Value)
+		}
+
+		logger.Info("... InitMissingParams done!", "subspace", paramspace.Name())
+	}
+}
+
+func PruneProposerIndex(k noding.Keeper) upgrade.UpgradeHandler {
+	return func(ctx sdk.Context, _ upgrade.Plan) {
+		logger := ctx.Logger().With("module", "x/upgrade")
+		logger.Info("Starting PruneProposerIndex ...")
+
+		k.PruneProposerIndex(ctx)
+
+		logger.Info("... PruneProposerIndex done!")
+	}
+}
+
+func MigrateVotingProposals(k votingKeeper.Keeper) upgrade.UpgradeHandler {
+	return func(ctx sdk.Context, _ upgrade.Plan) {
+		logger := ctx.Logger().With("module", "x/upgrade")
+		logger.Info("Starting MigrateVotingProposals ...")
+
+		k.MigrateLegacyProposal(ctx)
+
+		logger.Info("... MigrateVotingProposals done!")
+	}
+}
+
+func MigrateVotingPolls(k votingKeeper.Keeper) upgrade.UpgradeHandler {
+	return func(ctx sdk.Context, _ upgrade.Plan) {
+		logger := ctx.Logger().With("module", "x/upgrade")
+		logger.Info("Starting MigrateVotingPolls ...")
+
+		k.MigrateLegacyPolls(ctx)
+
+		logger.Info("... MigrateVotingPolls done!")
+	}
+}
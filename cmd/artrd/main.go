@@ -1,10 +1,12 @@
 package main
 
 import (
+	"context"
 	"io"
 	"os"
 	"strconv"
 
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
@@ -36,6 +38,7 @@ import (
 	"github.com/arterynetwork/artr/util"
 	"github.com/arterynetwork/artr/x/bank"
 	bankcmd "github.com/arterynetwork/artr/x/bank/client/cli"
+	nodingTypes "github.com/arterynetwork/artr/x/noding/types"
 )
 
 const flagInvCheckPeriod = "inv-check-period"
@@ -204,12 +207,37 @@ func cmdValidatorSet() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "tendermint-validator-set [height]",
 		Short: "Get the full tendermint validator set at given height",
-		Args:  cobra.MaximumNArgs(1),
+		Long: "Get the full tendermint validator set at given height.\n\n" +
+			"With the --height flag, the set is reconstructed from the noding module history instead of being " +
+			"requested from Tendermint, so it contains account addresses and works even if the block is pruned. " +
+			"Both forms return the set that signed the given block. Tendermint applies validator updates two blocks " +
+			"later than they are made, so it's the set the noding module chose at the end of block (height - 2).",
+		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
 				return err
 			}
+
+			if h, _ := cmd.Flags().GetInt64(flags.FlagHeight); h > 0 {
+				if len(args) > 0 {
+					return errors.New("height must be specified either as an argument or as a flag, not both")
+				}
+				// Validator updates made at the end of block H are applied by Tendermint at H+2
+				const updateDelay = 2
+				if h <= updateDelay {
+					return errors.Errorf("height must be greater than %d, the first blocks are signed by the genesis set", updateDelay)
+				}
+				// The set is reconstructed from the current state, so the state height itself must not be set
+				queryClient := nodingTypes.NewQueryClient(clientCtx.WithHeight(0))
+				res, err := queryClient.ValidatorSetAt(context.Background(), &nodingTypes.ValidatorSetAtRequest{Height: h - updateDelay})
+				if err != nil {
+					return err
+				}
+				res.Height = h
+				return util.PrintConsoleOutput(clientCtx, res)
+			}
+
 			var height *int64
 
 			// optional height
@@ -240,6 +268,7 @@ func cmdValidatorSet() *cobra.Command {
 	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test)")
 	cmd.Flags().Int(flags.FlagPage, rest.DefaultPage, "Query a specific page of paginated results")
 	cmd.Flags().Int(flags.FlagLimit, 100, "Query number of results returned per page")
+	cmd.Flags().Int64(flags.FlagHeight, 0, "Reconstruct the set at a given height from the noding module history")
 
 	return cmd
 }
@@ -52,7 +52,8 @@ type BaseSendKeeper struct {
 	blockedAddrs map[string]bool
 
 	// hooks to call
-	setCoinHooks map[string]func(ctx sdk.Context, addr sdk.AccAddress) error
+	setCoinHooks       map[string]func(ctx sdk.Context, addr sdk.AccAddress) error
+	beforeSetCoinHooks map[string]func(ctx sdk.Context, addr sdk.AccAddress) error
 }
 
 func NewBaseSendKeeper(
@@ -67,6 +68,8 @@ func NewBaseSendKeeper(
 		paramSpace:     paramSpace,
 		blockedAddrs:   blockedAddrs,
 		setCoinHooks:   make(map[string]func(ctx sdk.Context, addr sdk.AccAddress) error),
+
+		beforeSetCoinHooks: make(map[string]func(ctx sdk.Context, addr sdk.AccAddress) error),
 	}
 }
 
@@ -147,6 +150,8 @@ func (keeper BaseSendKeeper) AddHook(event string, name string, hook func(ctx sd
 	switch event {
 	case "SetCoins":
 		keeper.setCoinHooks[name] = hook
+	case "BeforeSetCoins":
+		keeper.beforeSetCoinHooks[name] = hook
 	default:
 		panic(errors.Errorf("unknown event: %s", event))
 
@@ -159,6 +164,10 @@ func (k BaseSendKeeper) SetBalance(ctx sdk.Context, addr sdk.AccAddress, balance
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, balance.String())
 	}
 
+	if err := k.fireBeforeSetCoins(ctx, addr); err != nil {
+		return errors.Wrap(err, "hook failed")
+	}
+
 	store := ctx.KVStore(k.storeKey)
 	key := make([]byte, len(types.BalancesPrefix)+len(addr.Bytes()))
 	copy(key, types.BalancesPrefix)
@@ -190,6 +199,16 @@ func (k BaseSendKeeper) fireSetCoins(ctx sdk.Context, addr sdk.AccAddress) error
 	return nil
 }
 
+// fireBeforeSetCoins calls hooks that need an account balance as it is before a change.
+func (k BaseSendKeeper) fireBeforeSetCoins(ctx sdk.Context, addr sdk.AccAddress) error {
+	for _, hook := range k.beforeSetCoinHooks {
+		if err := hook(ctx, addr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // GetSendEnabled returns the current SendEnabled
 func (keeper BaseSendKeeper) GetMinSend(ctx sdk.Context) int64 {
 	var minSend int64
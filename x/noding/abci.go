@@ -11,6 +11,9 @@ import (
 // BeginBlocker check for infraction evidence or downtime of validators
 // on every begin block
 func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k Keeper) {
+	k.PruneProposerIndex(ctx)
+	k.DecayPenalties(ctx)
+	k.PruneValidatorSetHistory(ctx)
 	if err := payProposerReward(ctx, req.Header.ProposerAddress, k); err != nil {
 		k.Logger(ctx).Error(
 			"Couldn't pay proposer reward",
@@ -16,12 +16,16 @@ const (
 	SwitchOffConst    = types.SwitchOffConst
 	UnjailConst       = types.UnjailConst
 
-	ValidatorStateOff   = types.VALIDATOR_STATE_OFF
-	ValidatorStateBan   = types.VALIDATOR_STATE_BAN
-	ValidatorStateJail  = types.VALIDATOR_STATE_JAIL
-	ValidatorStateSpare = types.VALIDATOR_STATE_SPARE
-	ValidatorStateLucky = types.VALIDATOR_STATE_LUCKY
-	ValidatorStateTop   = types.VALIDATOR_STATE_TOP
+	ValidatorStateOff    = types.VALIDATOR_STATE_OFF
+	ValidatorStateBan    = types.VALIDATOR_STATE_BAN
+	ValidatorStateJail   = types.VALIDATOR_STATE_JAIL
+	ValidatorStateSpare  = types.VALIDATOR_STATE_SPARE
+	ValidatorStateLucky  = types.VALIDATOR_STATE_LUCKY
+	ValidatorStateTop    = types.VALIDATOR_STATE_TOP
+	ValidatorStatePaused = types.VALIDATOR_STATE_PAUSED
+
+	LotteryModeQueue  = types.LOTTERY_MODE_QUEUE
+	LotteryModeRandom = types.LOTTERY_MODE_RANDOM
 )
 
 var (
@@ -32,14 +36,21 @@ var (
 	ValidateGenesis     = types.ValidateGenesis
 
 	// variable aliases
-	ModuleCdc            = types.ModuleCdc
-	ErrNotQualified      = types.ErrNotQualified
-	ErrPubkeyBusy        = types.ErrPubkeyBusy
-	ErrNotFound          = types.ErrNotFound
-	ErrNotJailed         = types.ErrNotJailed
-	ErrJailPeriodNotOver = types.ErrJailPeriodNotOver
-	ErrBannedForLifetime = types.ErrBannedForLifetime
-	ErrAlreadyOn         = types.ErrAlreadyOn
+	ModuleCdc                = types.ModuleCdc
+	ErrNotQualified          = types.ErrNotQualified
+	ErrPubkeyBusy            = types.ErrPubkeyBusy
+	ErrNotFound              = types.ErrNotFound
+	ErrNotJailed             = types.ErrNotJailed
+	ErrJailPeriodNotOver     = types.ErrJailPeriodNotOver
+	ErrBannedForLifetime     = types.ErrBannedForLifetime
+	ErrAlreadyOn             = types.ErrAlreadyOn
+	ErrProposerPruned        = types.ErrProposerPruned
+	ErrNoLotteryDraw         = types.ErrNoLotteryDraw
+	ErrKeyRotationTooSoon    = types.ErrKeyRotationTooSoon
+	ErrNoValidatorSetHistory = types.ErrNoValidatorSetHistory
+	ErrNotActive             = types.ErrNotActive
+	ErrNotPaused             = types.ErrNotPaused
+	ErrPauseTooLong          = types.ErrPauseTooLong
 )
 
 type (
@@ -48,4 +59,9 @@ type (
 	Params       = types.Params
 
 	ValidatorState = types.ValidatorState
+	LotteryMode    = types.LotteryMode
+
+	Eligibility                 = types.Eligibility
+	Eligibility_StakeCriterion  = types.Eligibility_StakeCriterion
+	Eligibility_StatusCriterion = types.Eligibility_StatusCriterion
 )
@@ -32,9 +32,15 @@ func NewQueryCmd() *cobra.Command {
 		cmdProposer(),
 		cmdIsAllowed(),
 		cmdOperator(),
+		cmdSigningInfo(),
+		cmdLotteryDraw(),
+		cmdScoreBreakdown(),
+		cmdEligibility(),
+		cmdRewardTotals(),
 		util.LineBreak(),
 		cmdSwitchedOn(),
 		cmdQueue(),
+		cmdStaffList(),
 		util.LineBreak(),
 		cmdParams(),
 	)
@@ -267,3 +273,169 @@ func cmdQueue() *cobra.Command {
 	util.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func cmdSigningInfo() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "signing-info <address>",
+		Aliases: []string{"si"},
+		Short:   "Get how many of the last blocks a validator has signed or missed",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.SigningInfoRequest{
+				Account: args[0],
+			}
+
+			res, err := queryClient.SigningInfo(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return util.PrintConsoleOutput(clientCtx, res)
+		},
+	}
+	util.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func cmdLotteryDraw() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "lottery-draw [height]",
+		Aliases: []string{"ld"},
+		Short:   "Get a random lucky validators draw input and result. Height is optional, default is the last draw.",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.LotteryDrawRequest{}
+
+			if len(args) > 0 {
+				h, err := strconv.ParseInt(args[0], 0, 64)
+				if err != nil {
+					return errors.Wrap(err, "cannot parse height")
+				}
+				req.Height = h
+			}
+
+			res, err := queryClient.LotteryDraw(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return util.PrintConsoleOutput(clientCtx, res)
+		},
+	}
+	util.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func cmdScoreBreakdown() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "score <address>",
+		Aliases: []string{"sc"},
+		Short:   "Get a validator's score split into delegation, uptime and penalty components",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.ScoreBreakdownRequest{
+				Account: args[0],
+			}
+
+			res, err := queryClient.ScoreBreakdown(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return util.PrintConsoleOutput(clientCtx, res)
+		},
+	}
+	util.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func cmdEligibility() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "eligibility <address>",
+		Aliases: []string{"el"},
+		Short:   "Check each noding requirement for an account and show what it lacks",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.EligibilityRequest{
+				Account: args[0],
+			}
+
+			res, err := queryClient.Eligibility(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return util.PrintConsoleOutput(clientCtx, res)
+		},
+	}
+	util.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func cmdStaffList() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "staff",
+		Aliases: []string{"st"},
+		Short:   "Get the list of staff validators with their grants and expiry",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.StaffListRequest{}
+
+			res, err := queryClient.StaffList(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return util.PrintConsoleOutput(clientCtx, res)
+		},
+	}
+	util.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func cmdRewardTotals() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "reward <address>",
+		Aliases: []string{"rw"},
+		Short:   "Get a validator's lifetime proposer reward totals",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.RewardTotalsRequest{
+				Account: args[0],
+			}
+
+			res, err := queryClient.RewardTotals(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return util.PrintConsoleOutput(clientCtx, res)
+		},
+	}
+	util.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
@@ -27,6 +28,9 @@ func NewTxCmd() *cobra.Command {
 		cmdOn(),
 		cmdOff(),
 		cmdUnjail(),
+		cmdRotateConsKey(),
+		cmdPause(),
+		cmdResume(),
 	)
 
 	return nodingTxCmd
@@ -122,3 +126,100 @@ func cmdUnjail() *cobra.Command {
 	util.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+func cmdRotateConsKey() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate-key <from key or address> <new node public key>",
+		Short: "Replace a node consensus key keeping validator's score and counters",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := cmd.Flags().Set(flags.FlagFrom, args[0])
+			if err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgRotateConsKey{
+				Account: args[0],
+				PubKey:  args[1],
+			}
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	util.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func cmdPause() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause <from key or address> <hours>",
+		Short: "Temporarily leave the validator set keeping validator's score and counters",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := cmd.Flags().Set(flags.FlagFrom, args[0])
+			if err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			hours, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgPause{
+				Account: args[0],
+				Hours:   uint32(hours),
+			}
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	util.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func cmdResume() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resume <from key or address>",
+		Short: "Come back from a pause before it's over",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := cmd.Flags().Set(flags.FlagFrom, args[0])
+			if err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgResume{
+				Account: args[0],
+			}
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	util.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -19,10 +19,14 @@ func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) []abci.ValidatorU
 	if err != nil {
 		panic(err)
 	}
+	k.SetLotteryDraws(ctx, data.LotteryDraws)
 	updz, err := k.GatherValidatorUpdates(ctx)
 	if err != nil {
 		panic(err)
 	}
+	if data.ValidatorSetHistoryStart != 0 || len(data.ValidatorSetChanges) != 0 {
+		k.SetValidatorSetHistory(ctx, data.ValidatorSetHistoryStart, data.ValidatorSetChanges)
+	}
 	return updz
 }
 
@@ -39,5 +43,6 @@ func ExportGenesis(ctx sdk.Context, k Keeper) (data *GenesisState) {
 	if err != nil {
 		panic(err)
 	}
-	return NewGenesisState(params, active, nonactive)
+	historyStart, changes := k.GetValidatorSetHistory(ctx)
+	return NewGenesisState(params, active, nonactive, k.GetLotteryDraws(ctx), changes, historyStart)
 }
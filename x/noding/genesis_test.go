@@ -207,8 +207,8 @@ func (s Suite) TestByzantine() {
 }
 
 func (s Suite) TestStaff() {
-	s.NoError(s.k.AddToStaff(s.ctx, app.DefaultGenesisUsers["user1"]))
-	s.NoError(s.k.AddToStaff(s.ctx, app.DefaultGenesisUsers["user13"]))
+	s.NoError(s.k.AddToStaff(s.ctx, app.DefaultGenesisUsers["user1"], "staff", "", 0))
+	s.NoError(s.k.AddToStaff(s.ctx, app.DefaultGenesisUsers["user13"], "staff", app.DefaultGenesisUsers["user1"].String(), 30))
 	s.checkExportImport()
 }
 
@@ -221,8 +221,37 @@ func (s Suite) TestProposers() {
 
 	s.nextBlock(user1key, nil, nil)
 	s.nextBlock(user2key, nil, nil)
-	s.Equal([]uint64{1}, s.k.GetBlocksProposedBy(s.ctx, user1))
-	s.Equal([]uint64{2}, s.k.GetBlocksProposedBy(s.ctx, user2))
+	heights, err := s.k.GetBlocksProposedBy(s.ctx, user1, 0)
+	s.NoError(err)
+	s.Equal([]uint64{1}, heights)
+	heights, err = s.k.GetBlocksProposedBy(s.ctx, user2, 0)
+	s.NoError(err)
+	s.Equal([]uint64{2}, heights)
+
+	s.checkExportImport()
+}
+
+func (s Suite) TestLotteryDraws() {
+	params := s.k.GetParams(s.ctx)
+	params.MaxValidators = 1
+	params.LotteryValidators = 1
+	params.LotteryMode = noding.LotteryModeRandom
+	s.k.SetParams(s.ctx, params)
+
+	user1key := sdk.MustGetPubKeyFromBech32(sdk.Bech32PubKeyTypeConsPub, app.DefaultUser1ConsPubKey)
+	for _, n := range []int{2, 3} {
+		_, key, _ := app.NewTestConsPubAddress()
+		s.NoError(s.k.SwitchOn(s.ctx, app.DefaultGenesisUsers[fmt.Sprintf("user%d", n)], key))
+	}
+
+	header := s.ctx.BlockHeader()
+	header.LastBlockId.Hash = []byte("previous block hash")
+	s.ctx = s.ctx.WithBlockHeader(header)
+	s.nextBlock(user1key, nil, nil)
+
+	draw, _, err := s.k.GetLotteryDraw(s.ctx, 0)
+	s.NoError(err)
+	s.Len(draw.Candidates, 2)
 
 	s.checkExportImport()
 }
@@ -250,6 +279,11 @@ func (s Suite) checkExportImport() {
 					}
 					height := binary.BigEndian.Uint64(bz[1:])
 					return fmt.Sprintf("H %d", height), nil
+				case 0x04:
+					if len(bz) != 21 {
+						return "", fmt.Errorf("wrong address length")
+					}
+					return fmt.Sprintf("SI %s", sdk.AccAddress(bz[1:])), nil
 				default:
 					return "", fmt.Errorf("unknown prefix")
 				}
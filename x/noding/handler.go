@@ -24,6 +24,15 @@ func NewHandler(k Keeper) sdk.Handler {
 		case *types.MsgUnjail:
 			res, err := srv.Unjail(sdkCtx, msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgRotateConsKey:
+			res, err := srv.RotateConsKey(sdkCtx, msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgPause:
+			res, err := srv.Pause(sdkCtx, msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgResume:
+			res, err := srv.Resume(sdkCtx, msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
 		}
@@ -5,6 +5,7 @@ import (
 	"encoding/binary"
 	"fmt"
 	"sort"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/pkg/errors"
@@ -32,6 +33,7 @@ type Keeper struct {
 	referralKeeper             types.ReferralKeeper
 	accountKeeper              types.AccountKeeper
 	bankKeeper                 types.BankKeeper
+	scheduleKeeper             types.ScheduleKeeper
 	paramspace                 types.ParamSubspace
 	feeCollectorName           string
 	splittableFeeCollectorName string
@@ -45,6 +47,7 @@ func NewKeeper(
 	referralKeeper types.ReferralKeeper,
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
+	scheduleKeeper types.ScheduleKeeper,
 	paramspace types.ParamSubspace,
 	feeCollectorName string,
 	splittableFeeCollectorName string,
@@ -56,6 +59,7 @@ func NewKeeper(
 		referralKeeper:             referralKeeper,
 		accountKeeper:              accountKeeper,
 		bankKeeper:                 bankKeeper,
+		scheduleKeeper:             scheduleKeeper,
 		paramspace:                 paramspace.WithKeyTable(types.ParamKeyTable()),
 		feeCollectorName:           feeCollectorName,
 		splittableFeeCollectorName: splittableFeeCollectorName,
@@ -66,6 +70,10 @@ func NewKeeper(
 var IdxPrefixNodeOperator = []byte{0x01}
 var IdxPrefixBlockProposer = []byte{0x02}
 var IdxPrefixLotteryQueue = []byte{0x03}
+var IdxPrefixSigningInfo = []byte{0x04}
+var IdxPrefixLotteryDraw = []byte{0x05}
+var IdxPrefixValidatorSetChange = []byte{0x06}
+var IdxKeyValidatorSetHistoryStart = []byte{0x07}
 
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
@@ -119,6 +127,72 @@ func (k Keeper) IsQualified(ctx sdk.Context, accAddr sdk.AccAddress) (result boo
 	return
 }
 
+// GetEligibility is like IsQualified but checks all the criteria (and ban/jail state) at once reporting how far
+// the account is from meeting each of them.
+func (k Keeper) GetEligibility(ctx sdk.Context, accAddr sdk.AccAddress) (result types.Eligibility, err error) {
+	delegation, err := k.referralKeeper.GetDelegatedInNetwork(ctx, accAddr.String(), 10)
+	if err != nil {
+		return
+	}
+	status, err := k.referralKeeper.GetStatus(ctx, accAddr.String())
+	if err != nil {
+		return
+	}
+	selfDelegation := k.bankKeeper.GetBalance(ctx, accAddr).AmountOf(util.ConfigDelegatedDenom)
+	minCriteria := k.GetParams(ctx).MinCriteria
+
+	result.Status = types.Eligibility_StatusCriterion{
+		Current: status,
+		Target:  minCriteria.Status,
+		Met:     status >= minCriteria.Status,
+	}
+	if !result.Status.Met {
+		result.Status.Shortfall = uint32(minCriteria.Status - status)
+		result.Reasons = append(result.Reasons, types.REASON_NOT_ENOUGH_STATUS)
+	}
+	result.SelfStake = stakeCriterion(selfDelegation.Uint64(), minCriteria.SelfStake)
+	if !result.SelfStake.Met {
+		result.Reasons = append(result.Reasons, types.REASON_NOT_ENOUGH_SELF_STAKE)
+	}
+	result.TotalStake = stakeCriterion(delegation.Uint64(), minCriteria.TotalStake)
+	if !result.TotalStake.Met {
+		result.Reasons = append(result.Reasons, types.REASON_NOT_ENOUGH_TOTAL_STAKE)
+	}
+
+	if k.has(ctx, accAddr) {
+		var d types.Info
+		d, err = k.Get(ctx, accAddr)
+		if err != nil {
+			return
+		}
+		result.Staff = d.Staff
+		result.Banned = d.BannedForLife
+		result.Jailed = d.Jailed
+		if d.Jailed {
+			result.UnjailAt = d.UnjailAt
+			if d.UnjailAt > ctx.BlockHeight() {
+				result.BlocksToUnjail = d.UnjailAt - ctx.BlockHeight()
+			}
+		}
+	}
+
+	result.Qualified = result.Staff || len(result.Reasons) == 0
+	result.Eligible = result.Qualified && !result.Banned && result.BlocksToUnjail == 0
+	return
+}
+
+func stakeCriterion(current, target uint64) types.Eligibility_StakeCriterion {
+	result := types.Eligibility_StakeCriterion{
+		Current: current,
+		Target:  target,
+		Met:     current >= target,
+	}
+	if !result.Met {
+		result.Shortfall = target - current
+	}
+	return result
+}
+
 // IsValidator returns true if an account presents in the validator pool
 // (i.e. if it can be potentially chosen for block signing).
 func (k Keeper) IsValidator(ctx sdk.Context, accAddr sdk.AccAddress) (bool, error) {
@@ -231,6 +305,49 @@ func (k Keeper) SwitchOn(ctx sdk.Context, accAddr sdk.AccAddress, key crypto.Pub
 	return nil
 }
 
+// RotateConsKey replaces a validator's consensus public key keeping its score, counters and lottery place. The old key
+// is replaced with the new one in the Tendermint validator set by the next GatherValidatorUpdates call.
+func (k Keeper) RotateConsKey(ctx sdk.Context, accAddr sdk.AccAddress, key crypto.PubKey) error {
+	data, err := k.Get(ctx, accAddr)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "cannot get data for %s", accAddr.String())
+	}
+	if data.BannedForLife {
+		return types.ErrBannedForLifetime
+	}
+	if cooldown := int64(k.GetParams(ctx).KeyRotationCooldown); data.KeyRotatedAt != 0 && ctx.BlockHeight() < data.KeyRotatedAt+cooldown {
+		return sdkerrors.Wrapf(types.ErrKeyRotationTooSoon, "next rotation is possible at height %d", data.KeyRotatedAt+cooldown)
+	}
+
+	newPubKey := bech32FromCryptoPubKey(key)
+	if newPubKey == data.PubKey {
+		return sdkerrors.Wrap(types.ErrPubkeyBusy, "it's the current key")
+	}
+	consAddr := sdk.GetConsAddress(key)
+	if operator, found := k.getNodeOperatorFromIndex(ctx, consAddr); found && !operator.Equals(accAddr) {
+		k.Logger(ctx).Error("public key is used by another operator", "pubKey", key, "operator", operator)
+		return types.ErrPubkeyBusy
+	}
+
+	if err := k.update(ctx, accAddr, func(d *types.Info) (save bool) {
+		d.PubKey = newPubKey
+		d.KeyRotatedAt = ctx.BlockHeight()
+		return true
+	}); err != nil {
+		return err
+	}
+	k.addToIndex(ctx, nodeOperatorIdxKey(consAddr), accAddr.Bytes())
+
+	util.EmitEvent(ctx,
+		&types.EventConsKeyRotated{
+			Address:   accAddr.String(),
+			OldPubKey: data.PubKey,
+			NewPubKey: newPubKey,
+		},
+	)
+	return nil
+}
+
 func (k Keeper) SwitchOff(ctx sdk.Context, accAddr sdk.AccAddress) error {
 	err := k.update(ctx, accAddr, func(d *types.Info) (save bool) {
 		if !d.Status {
@@ -244,6 +361,10 @@ func (k Keeper) SwitchOff(ctx sdk.Context, accAddr sdk.AccAddress) error {
 				panic(err)
 			}
 		}
+		if d.PausedUntil != nil {
+			k.scheduleKeeper.Delete(ctx, *d.PausedUntil, types.ResumeHookName, accAddr.Bytes())
+			d.PausedUntil = nil
+		}
 		return true
 	})
 	if err != nil {
@@ -335,9 +456,24 @@ func (k Keeper) GatherValidatorUpdates(ctx sdk.Context) ([]abci.ValidatorUpdate,
 	var (
 		store = ctx.KVStore(k.dataStoreKey)
 
-		result []abci.ValidatorUpdate
-		active []types.InfoWithAccount
+		result  []abci.ValidatorUpdate
+		changes []types.ValidatorSetChange
+		active  []types.InfoWithAccount
 	)
+	change := func(acc sdk.AccAddress, pubKey string, oldPower, newPower int64, reason types.ValidatorSetChangeReason) {
+		result = append(result, abci.ValidatorUpdate{
+			PubKey: abciPubKeyFromBech32(pubKey),
+			Power:  newPower,
+		})
+		changes = append(changes, types.ValidatorSetChange{
+			Height:   ctx.BlockHeight(),
+			Account:  acc.String(),
+			PubKey:   pubKey,
+			OldPower: oldPower,
+			NewPower: newPower,
+			Reason:   reason,
+		})
+	}
 
 	it := store.Iterator(nil, nil)
 	for ; it.Valid(); it.Next() {
@@ -357,10 +493,7 @@ func (k Keeper) GatherValidatorUpdates(ctx sdk.Context) ([]abci.ValidatorUpdate,
 					panic("non-zero LastPower is impossible without LastPubKey")
 				}
 
-				result = append(result, abci.ValidatorUpdate{
-					PubKey: abciPubKeyFromBech32(data.LastPubKey),
-					Power:  0,
-				})
+				change(addr, data.LastPubKey, data.LastPower, 0, types.VALIDATOR_SET_CHANGE_REASON_INACTIVE)
 				if err := k.update(ctx, addr, func(d *types.Info) (save bool) {
 					d.LastPower = 0
 					d.LastPubKey = ""
@@ -412,19 +545,13 @@ func (k Keeper) GatherValidatorUpdates(ctx sdk.Context) ([]abci.ValidatorUpdate,
 		updated := data.LastPower != power
 		if data.PubKey != data.LastPubKey {
 			if len(data.LastPubKey) != 0 {
-				result = append(result, abci.ValidatorUpdate{
-					PubKey: abciPubKeyFromBech32(data.LastPubKey),
-					Power:  0,
-				})
+				change(data.Account, data.LastPubKey, data.LastPower, 0, types.VALIDATOR_SET_CHANGE_REASON_KEY_ROTATION)
 			}
 			updated = true
 		}
 
 		if updated {
-			result = append(result, abci.ValidatorUpdate{
-				PubKey: abciPubKeyFromBech32(data.PubKey),
-				Power:  power,
-			})
+			change(data.Account, data.PubKey, lastPowerOfCurrentKey(data.Info), power, powerChangeReason(data.Info))
 		}
 		if data.LotteryNo != 0 {
 			if err := k.lotteryExclude(ctx, &data.Info); err != nil {
@@ -444,26 +571,27 @@ func (k Keeper) GatherValidatorUpdates(ctx sdk.Context) ([]abci.ValidatorUpdate,
 			}
 		}
 	}
-	maxLotNo := k.lotteryLastNo(ctx, n2)
+	var isLucky func(data types.InfoWithAccount) bool
+	if params.LotteryMode == types.LOTTERY_MODE_RANDOM {
+		lucky := k.luckyOnes(ctx, active[n1:], n2)
+		isLucky = func(data types.InfoWithAccount) bool { return lucky[data.Account.String()] }
+	} else {
+		maxLotNo := k.lotteryLastNo(ctx, n2)
+		isLucky = func(data types.InfoWithAccount) bool { return data.LotteryNo != 0 && data.LotteryNo <= maxLotNo }
+	}
 	for ; i < len(active); i++ {
 		data := active[i]
-		if data.LotteryNo != 0 && data.LotteryNo <= maxLotNo {
+		if isLucky(data) {
 			power := vpg.GetVotingPower(data.Score)
 			if data.PubKey != data.LastPubKey {
 				if len(data.LastPubKey) != 0 {
-					result = append(result, abci.ValidatorUpdate{
-						PubKey: abciPubKeyFromBech32(data.LastPubKey),
-						Power:  0,
-					})
+					change(data.Account, data.LastPubKey, data.LastPower, 0, types.VALIDATOR_SET_CHANGE_REASON_KEY_ROTATION)
 				}
 			} else if data.LastPower == power {
 				continue
 			}
 
-			result = append(result, abci.ValidatorUpdate{
-				PubKey: abciPubKeyFromBech32(data.PubKey),
-				Power:  power,
-			})
+			change(data.Account, data.PubKey, lastPowerOfCurrentKey(data.Info), power, powerChangeReason(data.Info))
 			if err := k.update(ctx, data.Account, func(d *types.Info) (save bool) {
 				d.LastPower = power
 				d.LastPubKey = d.PubKey
@@ -474,10 +602,7 @@ func (k Keeper) GatherValidatorUpdates(ctx sdk.Context) ([]abci.ValidatorUpdate,
 		} else {
 			updated := false
 			if data.LastPower != 0 {
-				result = append(result, abci.ValidatorUpdate{
-					PubKey: abciPubKeyFromBech32(data.LastPubKey),
-					Power:  0,
-				})
+				change(data.Account, data.LastPubKey, data.LastPower, 0, types.VALIDATOR_SET_CHANGE_REASON_NOT_CHOSEN)
 				updated = true
 			}
 			if data.LotteryNo == 0 {
@@ -516,6 +641,7 @@ func (k Keeper) GatherValidatorUpdates(ctx sdk.Context) ([]abci.ValidatorUpdate,
 		}
 	}
 
+	k.saveValidatorSetChanges(ctx, changes)
 	return unique, nil
 }
 
@@ -537,7 +663,7 @@ func (k Keeper) GetActiveValidators(ctx sdk.Context) ([]types.Validator, error)
 			continue
 		}
 		addr := sdk.AccAddress(it.Key())
-		result = append(result, types.GenesisValidatorFromD(addr, value, proposed[addr.String()]))
+		result = append(result, k.genesisValidator(ctx, addr, value, proposed[addr.String()]))
 	}
 
 	return result, nil
@@ -580,7 +706,7 @@ func (k Keeper) GetNonActiveValidators(ctx sdk.Context) ([]types.Validator, erro
 			continue
 		}
 		addr := sdk.AccAddress(it.Key())
-		result = append(result, types.GenesisValidatorFromD(addr, value, proposed[addr.String()]))
+		result = append(result, k.genesisValidator(ctx, addr, value, proposed[addr.String()]))
 	}
 
 	return result, nil
@@ -601,6 +727,9 @@ func (k Keeper) SetActiveValidators(ctx sdk.Context, validators []types.Validato
 		for _, h := range v.ProposedBlocks {
 			k.addProposerToIndex(ctx, int64(h), acc)
 		}
+		if v.SigningInfo != nil {
+			k.setSigningInfo(ctx, acc, *v.SigningInfo)
+		}
 		if err := k.SwitchOn(ctx, acc, pubkey); err != nil {
 			return errors.Wrap(err, "cannot switch on")
 		}
@@ -621,28 +750,45 @@ func (k Keeper) SetNonActiveValidators(ctx sdk.Context, validators []types.Valid
 		for _, h := range v.ProposedBlocks {
 			k.addProposerToIndex(ctx, int64(h), acc)
 		}
+		if v.SigningInfo != nil {
+			k.setSigningInfo(ctx, acc, *v.SigningInfo)
+		}
 	}
 	return nil
 }
 
+func (k Keeper) genesisValidator(ctx sdk.Context, acc sdk.AccAddress, info types.Info, proposedBlocks []uint64) types.Validator {
+	result := types.GenesisValidatorFromD(acc, info, proposedBlocks)
+	if si, found := k.GetSigningInfo(ctx, acc); found {
+		result.SigningInfo = &si
+	}
+	return result
+}
+
 // MarkStroke - to be called every time the validator misses a block.
 func (k Keeper) MarkStroke(ctx sdk.Context, acc sdk.AccAddress) error {
 	p := k.GetParams(ctx)
 
-	return k.update(ctx, acc, func(d *types.Info) (save bool) {
-		if d.Jailed {
+	var jailed bool
+	if err := k.update(ctx, acc, func(d *types.Info) (save bool) {
+		if d.Jailed || d.PausedUntil != nil {
 			return false
 		}
 
+		missedInWindow := k.markSigningWindow(ctx, acc, p.SignedBlocksWindow, true)
+
 		d.Score = d.Score - d.OkBlocksInRow/100 - 1
 		d.Strokes++
 		d.OkBlocksInRow = 0
 		d.MissedBlocksInRow++
-		if d.MissedBlocksInRow >= int64(p.JailAfter) {
+		if d.MissedBlocksInRow >= int64(p.JailAfter) ||
+			p.MaxMissedBlocksPerWindow != 0 && missedInWindow > p.MaxMissedBlocksPerWindow {
+
 			d.Jailed = true
 			d.UnjailAt = ctx.BlockHeight() + int64(p.UnjailAfter)
 			d.JailCount++
 			d.MissedBlocksInRow = 0
+			k.resetSigningInfo(ctx, acc)
 			if d.LotteryNo != 0 {
 				if err := k.lotteryExclude(ctx, d); err != nil {
 					// Should never happen
@@ -654,6 +800,7 @@ func (k Keeper) MarkStroke(ctx sdk.Context, acc sdk.AccAddress) error {
 					Address: acc.String(),
 				},
 			)
+			jailed = true
 		} else {
 			if d.LotteryNo != 0 {
 				if err := k.lotteryDownshift(ctx, acc, d); err != nil {
@@ -663,12 +810,26 @@ func (k Keeper) MarkStroke(ctx sdk.Context, acc sdk.AccAddress) error {
 			}
 		}
 		return true
-	})
+	}); err != nil {
+		return err
+	}
+
+	if jailed {
+		return k.slash(ctx, acc, p.SlashFractionDowntime, types.SLASH_REASON_DOWNTIME)
+	}
+	return nil
 }
 
 // MarkTick - to be called every time the validator signs a block successfully.
 func (k Keeper) MarkTick(ctx sdk.Context, acc sdk.AccAddress) error {
+	p := k.GetParams(ctx)
+
 	return k.update(ctx, acc, func(d *types.Info) (save bool) {
+		if d.PausedUntil != nil {
+			return false
+		}
+		k.markSigningWindow(ctx, acc, p.SignedBlocksWindow, false)
+
 		d.MissedBlocksInRow = 0
 		d.OkBlocksInRow++
 		if d.OkBlocksInRow%100 == 0 {
@@ -679,7 +840,7 @@ func (k Keeper) MarkTick(ctx sdk.Context, acc sdk.AccAddress) error {
 }
 
 func (k Keeper) MarkByzantine(ctx sdk.Context, acc sdk.AccAddress, evidence abci.Evidence) error {
-	return k.update(ctx, acc, func(d *types.Info) (save bool) {
+	if err := k.update(ctx, acc, func(d *types.Info) (save bool) {
 		d.Infractions = append(d.Infractions, evidence)
 		event := types.EventByzantine{
 			Address:   acc.String(),
@@ -706,7 +867,43 @@ func (k Keeper) MarkByzantine(ctx sdk.Context, acc sdk.AccAddress, evidence abci
 		}
 		util.EmitEvent(ctx, &event)
 		return true
-	})
+	}); err != nil {
+		return err
+	}
+
+	return k.slash(ctx, acc, k.GetParams(ctx).SlashFractionDoubleSign, types.SLASH_REASON_DOUBLE_SIGN)
+}
+
+// slash burns the specified part of the validator's own delegation. It must not be called from inside an update
+// callback: burning coins triggers the referral OnBalanceChanged hook, which may update the validator's record too.
+func (k Keeper) slash(ctx sdk.Context, acc sdk.AccAddress, fraction util.Fraction, reason types.SlashReason) error {
+	if fraction.IsNullValue() || fraction.IsZero() {
+		return nil
+	}
+
+	delegated := k.bankKeeper.GetBalance(ctx, acc).AmountOf(util.ConfigDelegatedDenom)
+	amount := sdk.NewCoins(sdk.NewInt64Coin(util.ConfigDelegatedDenom, fraction.MulInt64(delegated.Int64()).Int64()))
+	if amount.IsZero() {
+		return nil
+	}
+
+	util.EmitEvent(ctx,
+		&bankTypes.EventBurn{
+			Account: acc.String(),
+			Amount:  amount,
+		},
+	)
+	if err := k.bankKeeper.BurnAccCoins(ctx, acc, amount); err != nil {
+		return errors.Wrap(err, "cannot slash validator")
+	}
+	util.EmitEvent(ctx,
+		&types.EventValidatorSlashed{
+			Address: acc.String(),
+			Reason:  reason,
+			Amount:  amount,
+		},
+	)
+	return nil
 }
 
 func (k Keeper) Unjail(ctx sdk.Context, acc sdk.AccAddress) error {
@@ -759,12 +956,6 @@ func (k Keeper) PayProposerReward(ctx sdk.Context, acc sdk.AccAddress) (err erro
 		return err
 	}
 
-	amount := k.bankKeeper.GetBalance(ctx, k.accountKeeper.GetModuleAddress(k.feeCollectorName))
-	if !amount.IsZero() {
-		if err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, k.feeCollectorName, acc, amount); err != nil {
-			return err
-		}
-	}
 	splittableFeeCollectorAcc := k.accountKeeper.GetModuleAddress(k.splittableFeeCollectorName)
 	splittableAmount := k.bankKeeper.GetBalance(ctx, splittableFeeCollectorAcc)
 	splittable := splittableAmount.AmountOf(util.ConfigMainDenom)
@@ -777,7 +968,7 @@ func (k Keeper) PayProposerReward(ctx sdk.Context, acc sdk.AccAddress) (err erro
 	}
 	forProposerAmount := sdk.NewCoins(sdk.NewCoin(util.ConfigMainDenom, forProposer))
 	if !forProposerAmount.IsZero() {
-		if err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, k.splittableFeeCollectorName, acc, forProposerAmount); err != nil {
+		if err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, k.splittableFeeCollectorName, k.feeCollectorName, forProposerAmount); err != nil {
 			return err
 		}
 	}
@@ -818,31 +1009,154 @@ func (k Keeper) PayProposerReward(ctx sdk.Context, acc sdk.AccAddress) (err erro
 			return err
 		}
 	}
+	return k.payProposer(ctx, acc)
+}
+
+// payProposer sends the whole fee collector balance to a block proposer. If it's set by params, a part of the reward
+// goes to the proposer's referral uplines (proportionally to their validator fees on delegating).
+func (k Keeper) payProposer(ctx sdk.Context, acc sdk.AccAddress) error {
+	amount := k.bankKeeper.GetBalance(ctx, k.accountKeeper.GetModuleAddress(k.feeCollectorName))
+	if amount.IsZero() {
+		return nil
+	}
+
+	var (
+		shares []types.RewardShare
+		shared sdk.Coins
+	)
+	if share := k.GetParams(ctx).ProposerRewardUplineShare; !share.IsNullValue() && share.IsPositive() {
+		fees, err := k.referralKeeper.GetReferralValidatorFeesForDelegating(ctx, acc.String())
+		if err != nil {
+			return err
+		}
+		totalRatio := util.FractionZero()
+		for _, fee := range fees {
+			totalRatio = totalRatio.Add(fee.Ratio)
+		}
+		if totalRatio.IsPositive() {
+			pool := share.MulInt64(amount.AmountOf(util.ConfigMainDenom).Int64())
+			for _, fee := range fees {
+				x := pool.Mul(fee.Ratio).Div(totalRatio).Int64()
+				if x == 0 {
+					continue
+				}
+				coins := sdk.NewCoins(sdk.NewCoin(util.ConfigMainDenom, sdk.NewInt(x)))
+				if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, k.feeCollectorName, fee.GetBeneficiary(), coins); err != nil {
+					return err
+				}
+				shares = append(shares, types.RewardShare{Account: fee.Beneficiary, Amount: coins})
+				shared = shared.Add(coins...)
+			}
+		}
+	}
+
+	kept := amount.Sub(shared)
+	if !kept.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, k.feeCollectorName, acc, kept); err != nil {
+			return err
+		}
+	}
+	if err := k.update(ctx, acc, func(d *types.Info) (save bool) {
+		d.RewardTotal = sdk.Coins(d.RewardTotal).Add(kept...)
+		d.RewardSharedTotal = sdk.Coins(d.RewardSharedTotal).Add(shared...)
+		return true
+	}); err != nil {
+		return err
+	}
+
+	util.EmitEvent(ctx,
+		&types.EventProposerRewarded{
+			Address: acc.String(),
+			Amount:  kept,
+			Shares:  shares,
+		},
+	)
 	return nil
 }
 
+// GetRewardTotals returns how many blocks a validator has proposed and how much reward it's kept and shared with its
+// referral uplines for the all time.
+func (k Keeper) GetRewardTotals(ctx sdk.Context, acc sdk.AccAddress) (proposedCount int64, kept, shared sdk.Coins, err error) {
+	data, err := k.Get(ctx, acc)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	return data.ProposedCount, data.RewardTotal, data.RewardSharedTotal, nil
+}
+
 func (k Keeper) GetBlockProposer(ctx sdk.Context, height int64) (sdk.AccAddress, error) {
 	result, found := k.getProposerFromIndex(ctx, height)
 	if !found {
+		if start := k.proposerIndexStart(ctx); height < start {
+			return nil, sdkerrors.Wrapf(types.ErrProposerPruned, "height %d is less than %d", height, start)
+		}
 		return nil, types.ErrNotFound
 	}
 	return result, nil
 }
 
-func (k Keeper) AddToStaff(ctx sdk.Context, acc sdk.AccAddress) (err error) {
+// PruneProposerIndex deletes block proposers that are older than the proposer_index_retention param allows.
+func (k Keeper) PruneProposerIndex(ctx sdk.Context) {
+	start := k.proposerIndexStart(ctx)
+	if start <= 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.indexStoreKey)
+	it := store.Iterator(proposerIdxKey(0), proposerIdxKey(start))
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+	it.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// proposerIndexStart returns the least block height whose proposer is still kept in the store.
+func (k Keeper) proposerIndexStart(ctx sdk.Context) int64 {
+	retention := int64(k.GetParams(ctx).ProposerIndexRetention)
+	if retention == 0 || ctx.BlockHeight() <= retention {
+		return 0
+	}
+	return ctx.BlockHeight() - retention
+}
+
+// AddToStaff makes an account a staff member (i.e. allows it to validate regardless of min criteria) for the specified
+// number of days (0 means forever). If the account is a staff member already, the grant is replaced.
+func (k Keeper) AddToStaff(ctx sdk.Context, acc sdk.AccAddress, proposal, author string, days uint32) (err error) {
+	grant := &types.StaffGrant{
+		Proposal: proposal,
+		Author:   author,
+		Height:   ctx.BlockHeight(),
+	}
+	if days != 0 {
+		until := ctx.BlockTime().Add(time.Duration(days) * k.scheduleKeeper.OneDay(ctx))
+		grant.Until = &until
+	}
+
 	if k.has(ctx, acc) {
 		err = k.update(ctx, acc, func(d *types.Info) (save bool) {
-			if d.Staff {
-				return false
-			}
+			k.cancelStaffExpiry(ctx, acc, d.StaffGrant)
 
 			d.Staff = true
+			d.StaffGrant = grant
 			return true
 		})
 	} else {
-		err = k.set(ctx, acc, types.Info{Staff: true})
+		err = k.set(ctx, acc, types.Info{Staff: true, StaffGrant: grant})
 	}
-	return err
+	if err != nil {
+		return err
+	}
+
+	// NOTE: The previous grant's task must be cancelled first, it may be scheduled at the very same time.
+	if grant.Until != nil {
+		k.scheduleKeeper.ScheduleTask(ctx, *grant.Until, types.StaffExpiryHookName, acc.Bytes())
+	}
+	return nil
 }
 
 func (k Keeper) RemoveFromStaff(ctx sdk.Context, acc sdk.AccAddress) (err error) {
@@ -858,7 +1172,9 @@ func (k Keeper) RemoveFromStaff(ctx sdk.Context, acc sdk.AccAddress) (err error)
 			return false
 		}
 
+		k.cancelStaffExpiry(ctx, acc, d.StaffGrant)
 		d.Staff = false
+		d.StaffGrant = nil
 		return true
 	})
 	if err != nil {
@@ -877,15 +1193,67 @@ func (k Keeper) RemoveFromStaff(ctx sdk.Context, acc sdk.AccAddress) (err error)
 	return nil
 }
 
-func (k Keeper) GetBlocksProposedBy(ctx sdk.Context, acc sdk.AccAddress) (heights []uint64) {
-	it := sdk.KVStorePrefixIterator(ctx.KVStore(k.indexStoreKey), IdxPrefixBlockProposer)
+// PerformStaffExpiry is a schedule hook removing an account from staff after its grant is over.
+func (k Keeper) PerformStaffExpiry(ctx sdk.Context, data []byte, _ time.Time) {
+	acc := sdk.AccAddress(data)
+	info, err := k.Get(ctx, acc)
+	if err != nil {
+		panic(err)
+	}
+	if !info.Staff || info.StaffGrant == nil || info.StaffGrant.Until == nil || ctx.BlockTime().Before(*info.StaffGrant.Until) {
+		return
+	}
+
+	if err := k.RemoveFromStaff(ctx, acc); err != nil {
+		panic(err)
+	}
+	util.EmitEvent(ctx,
+		&types.EventStaffExpired{
+			Address:  acc.String(),
+			Proposal: info.StaffGrant.Proposal,
+		},
+	)
+}
+
+func (k Keeper) cancelStaffExpiry(ctx sdk.Context, acc sdk.AccAddress, grant *types.StaffGrant) {
+	if grant != nil && grant.Until != nil {
+		k.scheduleKeeper.Delete(ctx, *grant.Until, types.StaffExpiryHookName, acc.Bytes())
+	}
+}
+
+// GetStaff returns all staff members along with their grants (if recorded).
+func (k Keeper) GetStaff(ctx sdk.Context) (result []types.StaffListResponse_Item) {
+	it := ctx.KVStore(k.dataStoreKey).Iterator(nil, nil)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var value types.Info
+		k.cdc.MustUnmarshalBinaryBare(it.Value(), &value)
+		if !value.Staff {
+			continue
+		}
+		result = append(result, types.StaffListResponse_Item{
+			Account: sdk.AccAddress(it.Key()).String(),
+			Grant:   value.StaffGrant,
+		})
+	}
+	return result
+}
+
+// GetBlocksProposedBy returns heights of blocks proposed by the account, starting from the specified height. It fails
+// if some blocks since the height are pruned already.
+func (k Keeper) GetBlocksProposedBy(ctx sdk.Context, acc sdk.AccAddress, since int64) (heights []uint64, err error) {
+	if start := k.proposerIndexStart(ctx); since < start {
+		return nil, sdkerrors.Wrapf(types.ErrProposerPruned, "height %d is less than %d", since, start)
+	}
+
+	it := ctx.KVStore(k.indexStoreKey).Iterator(proposerIdxKey(since), sdk.PrefixEndBytes(IdxPrefixBlockProposer))
 	defer it.Close()
 	for ; it.Valid(); it.Next() {
 		if bytes.Equal(it.Value(), acc.Bytes()) {
 			heights = append(heights, binary.BigEndian.Uint64(it.Key()[len(IdxPrefixBlockProposer):]))
 		}
 	}
-	return heights
+	return heights, nil
 }
 
 func (k Keeper) GetBlocksProposedByAll(ctx sdk.Context) (heightsByAccAddress map[string][]uint64) {
@@ -923,6 +1291,51 @@ func (k Keeper) GeneralAmnesty(ctx sdk.Context) {
 	}
 }
 
+// DecayPenalties reduces all validators' strokes by the penalty_decay_amount param every penalty_decay_period blocks.
+func (k Keeper) DecayPenalties(ctx sdk.Context) {
+	p := k.GetParams(ctx)
+	if p.PenaltyDecayPeriod == 0 || ctx.BlockHeight()%int64(p.PenaltyDecayPeriod) != 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.dataStoreKey)
+	it := store.Iterator(nil, nil)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var item types.Info
+		if err := proto.Unmarshal(it.Value(), &item); err != nil {
+			panic(errors.Wrap(err, "cannot unmarshal info"))
+		}
+		if item.Strokes == 0 {
+			continue
+		}
+		forgiven := int64(p.PenaltyDecayAmount)
+		if forgiven > item.Strokes {
+			forgiven = item.Strokes
+		}
+		item.Score += forgiven
+		item.Strokes -= forgiven
+		if bz, err := proto.Marshal(&item); err != nil {
+			panic(errors.Wrap(err, "cannot marshal info"))
+		} else {
+			store.Set(it.Key(), bz)
+		}
+	}
+}
+
+// GetScoreBreakdown returns a validator's score and its components: delegation, uptime and penalty.
+func (k Keeper) GetScoreBreakdown(ctx sdk.Context, acc sdk.AccAddress) (score, delegation, uptime, penalty int64, err error) {
+	data, err := k.Get(ctx, acc)
+	if err != nil {
+		return 0, 0, 0, 0, err
+	}
+	stake, err := k.referralKeeper.GetDelegatedInNetwork(ctx, acc.String(), 10)
+	if err != nil {
+		return 0, 0, 0, 0, errors.Wrap(err, "cannot obtain stake")
+	}
+	return data.Score, types.DelegationScore(stake.Int64()), data.UptimeScore(), data.PenaltyScore(), nil
+}
+
 func (k Keeper) GetValidatorState(ctx sdk.Context, acc sdk.AccAddress) types.ValidatorState {
 	data, err := k.Get(ctx, acc)
 	if err != nil {
@@ -937,6 +1350,9 @@ func (k Keeper) GetValidatorState(ctx sdk.Context, acc sdk.AccAddress) types.Val
 	if !data.Status {
 		return types.VALIDATOR_STATE_OFF
 	}
+	if data.PausedUntil != nil {
+		return types.VALIDATOR_STATE_PAUSED
+	}
 	if data.LastPower == 0 {
 		return types.VALIDATOR_STATE_SPARE
 	}
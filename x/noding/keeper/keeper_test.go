@@ -3,7 +3,9 @@
 package keeper_test
 
 import (
+	"errors"
 	"fmt"
+	"io/ioutil"
 	"testing"
 	"time"
 
@@ -84,7 +86,7 @@ func (s *Suite) TestSwitchOn() {
 }
 
 func (s *Suite) TestAddToStaff() {
-	s.NoError(s.k.AddToStaff(s.ctx, s.user(15)))
+	s.NoError(s.k.AddToStaff(s.ctx, s.user(15), "staff", "", 0))
 
 	qualified, _, _, err := s.k.IsQualified(s.ctx, s.user(15))
 	s.NoError(err)
@@ -102,6 +104,53 @@ func (s *Suite) TestAddToStaff() {
 	)
 }
 
+func (s *Suite) TestStaffExpiry() {
+	proposerKey := sdk.MustGetPubKeyFromBech32(sdk.Bech32PubKeyTypeConsPub, app.DefaultUser1ConsPubKey)
+	_, pubkey, _ := app.NewTestConsPubAddress()
+	tmPubKey, _ := cryptocodec.ToTmProtoPublicKey(pubkey)
+	s.NoError(s.k.AddToStaff(s.ctx, s.user(15), "staff", s.user(1).String(), 1))
+	s.NoError(s.k.SwitchOn(s.ctx, s.user(15), pubkey))
+
+	staff := s.k.GetStaff(s.ctx)
+	s.Len(staff, 1)
+	s.Equal(s.user(15).String(), staff[0].Account)
+	s.Equal("staff", staff[0].Grant.Proposal)
+	s.Equal(s.user(1).String(), staff[0].Grant.Author)
+	s.Equal(s.ctx.BlockHeight(), staff[0].Grant.Height)
+	s.NotNil(staff[0].Grant.Until)
+	until := *staff[0].Grant.Until
+
+	ebr, _ := s.nextBlock(proposerKey, nil, nil)
+	s.Equal([]abci.ValidatorUpdate{{PubKey: tmPubKey, Power: 15}}, ebr.ValidatorUpdates)
+
+	s.ctx = s.ctx.WithBlockTime(until.Add(-30 * time.Second))
+	s.nextBlock(proposerKey, nil, nil)
+	s.Empty(s.k.GetStaff(s.ctx))
+	info, err := s.k.Get(s.ctx, s.user(15))
+	s.NoError(err)
+	s.False(info.Staff)
+	s.Nil(info.StaffGrant)
+
+	ebr, _ = s.nextBlock(proposerKey, nil, nil)
+	s.Equal([]abci.ValidatorUpdate{{PubKey: tmPubKey, Power: 0}}, ebr.ValidatorUpdates, "not qualified anymore")
+}
+
+func (s *Suite) TestStaffExpiry_RegrantSameBlock() {
+	proposerKey := sdk.MustGetPubKeyFromBech32(sdk.Bech32PubKeyTypeConsPub, app.DefaultUser1ConsPubKey)
+	s.NoError(s.k.AddToStaff(s.ctx, s.user(15), "staff", s.user(1).String(), 1))
+	s.NoError(s.k.AddToStaff(s.ctx, s.user(15), "staff again", s.user(1).String(), 1))
+
+	staff := s.k.GetStaff(s.ctx)
+	s.Len(staff, 1)
+	s.Equal("staff again", staff[0].Grant.Proposal)
+	s.NotNil(staff[0].Grant.Until)
+	until := *staff[0].Grant.Until
+
+	s.ctx = s.ctx.WithBlockTime(until.Add(-30 * time.Second))
+	s.nextBlock(proposerKey, nil, nil)
+	s.Empty(s.k.GetStaff(s.ctx))
+}
+
 func (s *Suite) TestRemoveFromStaff() {
 	var pubkeys [2]crypto.PubKey
 	var tmPubKeys [2]tmcrypto.PublicKey
@@ -109,8 +158,8 @@ func (s *Suite) TestRemoveFromStaff() {
 		_, pubkeys[i], _ = app.NewTestConsPubAddress()
 		tmPubKeys[i], _ = cryptocodec.ToTmProtoPublicKey(pubkeys[i])
 	}
-	_ = s.k.AddToStaff(s.ctx, s.user(2))
-	_ = s.k.AddToStaff(s.ctx, s.user(15))
+	_ = s.k.AddToStaff(s.ctx, s.user(2), "staff", "", 0)
+	_ = s.k.AddToStaff(s.ctx, s.user(15), "staff", "", 0)
 	_ = s.k.SwitchOn(s.ctx, s.user(2), pubkeys[0])
 	_ = s.k.SwitchOn(s.ctx, s.user(15), pubkeys[1])
 
@@ -166,6 +215,84 @@ func (s *Suite) TestProposerAward() {
 	} else {
 		s.Equal(int64(1), data.ProposedCount)
 	}
+	count, kept, shared, err := s.k.GetRewardTotals(s.ctx, s.user(2))
+	s.NoError(err)
+	s.Equal(int64(1), count)
+	s.Equal(int64(10_000000), kept.AmountOf(util.ConfigMainDenom).Int64())
+	s.True(shared.IsZero())
+}
+
+func (s *Suite) TestProposerRewardUplineShare_ActiveUpline() {
+	// The root is a champion and a genesis validator there, so its referral's reward can be shared with it.
+	data, err := ioutil.ReadFile("../../referral/keeper/test-genesis-status-3x3.json")
+	if err != nil {
+		panic(err)
+	}
+	s.cleanup()
+	s.setupTest(data)
+	root := app.DefaultGenesisUsers["root"]
+	proposer, err := sdk.AccAddressFromBech32("artr1u574gq6xcplupp7jy65fkzcfmayr24wmr5883n")
+	s.NoError(err)
+
+	params := s.k.GetParams(s.ctx)
+	params.ProposerRewardUplineShare = util.Percent(50)
+	s.k.SetParams(s.ctx, params)
+
+	// A validator counts as active once it's been validating long enough.
+	s.ctx = s.ctx.WithBlockHeight(6*int64(params.UnjailAfter) + 1)
+	active, err := s.k.IsActiveValidator(s.ctx, root)
+	s.NoError(err)
+	s.True(active)
+
+	_, pubkey, _ := app.NewTestConsPubAddress()
+	s.NoError(s.k.AddToStaff(s.ctx, proposer, "staff", "", 0))
+	s.NoError(s.k.SwitchOn(s.ctx, proposer, pubkey))
+	if err := s.bk.SendCoinsFromAccountToModule(
+		s.ctx, root, auth.FeeCollectorName,
+		sdk.NewCoins(sdk.NewCoin(util.ConfigMainDenom, sdk.NewInt(10_000000))),
+	); err != nil {
+		panic(err)
+	}
+	proposerBalance0 := s.bk.GetBalance(s.ctx, proposer).AmountOf(util.ConfigMainDenom).Int64()
+	uplineBalance0 := s.bk.GetBalance(s.ctx, root).AmountOf(util.ConfigMainDenom).Int64()
+
+	s.nextBlock(pubkey, nil, nil)
+
+	proposerBalance := s.bk.GetBalance(s.ctx, proposer).AmountOf(util.ConfigMainDenom).Int64()
+	uplineBalance := s.bk.GetBalance(s.ctx, root).AmountOf(util.ConfigMainDenom).Int64()
+	s.Equal(int64(5_000000), proposerBalance-proposerBalance0)
+	s.Equal(int64(5_000000), uplineBalance-uplineBalance0)
+
+	_, kept, shared, err := s.k.GetRewardTotals(s.ctx, proposer)
+	s.NoError(err)
+	s.Equal(int64(5_000000), kept.AmountOf(util.ConfigMainDenom).Int64())
+	s.Equal(int64(5_000000), shared.AmountOf(util.ConfigMainDenom).Int64())
+}
+
+func (s *Suite) TestProposerRewardUplineShare() {
+	params := s.k.GetParams(s.ctx)
+	params.ProposerRewardUplineShare = util.Percent(50)
+	s.k.SetParams(s.ctx, params)
+
+	_, pubkey, _ := app.NewTestConsPubAddress()
+	s.NoError(s.k.SwitchOn(s.ctx, s.user(2), pubkey))
+	if err := s.bk.SendCoinsFromAccountToModule(
+		s.ctx, s.user(1), auth.FeeCollectorName,
+		sdk.NewCoins(sdk.NewCoin(util.ConfigMainDenom, sdk.NewInt(10_000000))),
+	); err != nil {
+		panic(err)
+	}
+	balance0 := s.bk.GetBalance(s.ctx, s.user(2)).AmountOf(util.ConfigMainDenom).Int64()
+
+	s.nextBlock(pubkey, nil, nil)
+
+	// Nobody upline is an active validator, so there's no one to share with.
+	balance := s.bk.GetBalance(s.ctx, s.user(2)).AmountOf(util.ConfigMainDenom).Int64()
+	s.Equal(int64(10_000000), balance-balance0)
+	_, kept, shared, err := s.k.GetRewardTotals(s.ctx, s.user(2))
+	s.NoError(err)
+	s.Equal(int64(10_000000), kept.AmountOf(util.ConfigMainDenom).Int64())
+	s.True(shared.IsZero())
 }
 
 func (s *Suite) TestByzantine() {
@@ -220,7 +347,7 @@ func (s *Suite) TestByzantine() {
 
 	// Banned node cannot be switched on by any means
 	s.Equal(noding.ErrBannedForLifetime, s.k.SwitchOn(s.ctx, s.user(2), pubkey))
-	if err := s.k.AddToStaff(s.ctx, s.user(2)); err != nil {
+	if err := s.k.AddToStaff(s.ctx, s.user(2), "staff", "", 0); err != nil {
 		panic(err)
 	}
 	s.Equal(noding.ErrBannedForLifetime, s.k.SwitchOn(s.ctx, s.user(2), pubkey))
@@ -449,6 +576,227 @@ func (s *Suite) TestDoubleJail() {
 	}
 }
 
+func (s *Suite) TestSigningWindow() {
+	pz := s.k.GetParams(s.ctx)
+	pz.JailAfter = 100
+	pz.SignedBlocksWindow = 5
+	pz.MaxMissedBlocksPerWindow = 2
+	s.k.SetParams(s.ctx, pz)
+
+	proposerKey := sdk.MustGetPubKeyFromBech32(sdk.Bech32PubKeyTypeConsPub, app.DefaultUser1ConsPubKey)
+	_, pubkey, _ := app.NewTestConsPubAddress()
+	s.NoError(s.k.SwitchOn(s.ctx, s.user(2), pubkey))
+
+	validator := abci.Validator{
+		Address: pubkey.Address().Bytes(),
+		Power:   10,
+	}
+	vote := func(signed bool) []abci.VoteInfo {
+		return []abci.VoteInfo{{Validator: validator, SignedLastBlock: signed}}
+	}
+
+	for _, signed := range []bool{false, true, true, false, true, false} {
+		s.nextBlock(proposerKey, vote(signed), nil)
+	}
+	info, found := s.k.GetSigningInfo(s.ctx, s.user(2))
+	s.True(found)
+	s.Equal(uint32(2), info.MissedCount)
+	s.Equal([]bool{false, false, true, false, true}, info.Window())
+	data, err := s.k.Get(s.ctx, s.user(2))
+	s.NoError(err)
+	s.False(data.Jailed, "the 1st missed block is out of the window already")
+
+	s.nextBlock(proposerKey, vote(false), nil)
+	data, err = s.k.Get(s.ctx, s.user(2))
+	s.NoError(err)
+	s.True(data.Jailed, "3 of 5 blocks missed")
+	_, found = s.k.GetSigningInfo(s.ctx, s.user(2))
+	s.False(found, "window is reset on jail")
+}
+
+func (s *Suite) TestProposerIndexPruning() {
+	pz := s.k.GetParams(s.ctx)
+	pz.ProposerIndexRetention = 2
+	s.k.SetParams(s.ctx, pz)
+
+	proposerKey := sdk.MustGetPubKeyFromBech32(sdk.Bech32PubKeyTypeConsPub, app.DefaultUser1ConsPubKey)
+	h0 := s.ctx.BlockHeight()
+	for i := 0; i < 4; i++ {
+		s.nextBlock(proposerKey, nil, nil)
+	}
+
+	acc, err := s.k.GetBlockProposer(s.ctx, s.ctx.BlockHeight()-1)
+	s.NoError(err)
+	s.Equal(s.user(1), acc)
+
+	_, err = s.k.GetBlockProposer(s.ctx, h0)
+	s.True(errors.Is(err, noding.ErrProposerPruned), err)
+	_, err = s.k.GetBlocksProposedBy(s.ctx, s.user(1), h0)
+	s.True(errors.Is(err, noding.ErrProposerPruned), err)
+
+	heights, err := s.k.GetBlocksProposedBy(s.ctx, s.user(1), s.ctx.BlockHeight()-2)
+	s.NoError(err)
+	s.Equal([]uint64{uint64(s.ctx.BlockHeight() - 2), uint64(s.ctx.BlockHeight() - 1)}, heights)
+}
+
+func (s *Suite) TestSlashing() {
+	pz := s.k.GetParams(s.ctx)
+	pz.JailAfter = 2
+	pz.SlashFractionDoubleSign = util.Percent(10)
+	pz.SlashFractionDowntime = util.Percent(1)
+	s.k.SetParams(s.ctx, pz)
+
+	proposerKey := sdk.MustGetPubKeyFromBech32(sdk.Bech32PubKeyTypeConsPub, app.DefaultUser1ConsPubKey)
+	_, pubkey, _ := app.NewTestConsPubAddress()
+	s.NoError(s.k.SwitchOn(s.ctx, s.user(2), pubkey))
+	validator := abci.Validator{
+		Address: pubkey.Address().Bytes(),
+		Power:   10,
+	}
+	delegated := func() int64 {
+		return s.bk.GetBalance(s.ctx, s.user(2)).AmountOf(util.ConfigDelegatedDenom).Int64()
+	}
+	before := delegated()
+	s.True(before > 0)
+
+	s.nextBlock(proposerKey, []abci.VoteInfo{{Validator: validator, SignedLastBlock: true}}, []abci.Evidence{{
+		Type:             abci.EvidenceType_DUPLICATE_VOTE,
+		Validator:        validator,
+		Height:           s.ctx.BlockHeight(),
+		TotalVotingPower: 20,
+	}})
+	afterByzantine := delegated()
+	s.Equal(before-before/10, afterByzantine)
+
+	votes := []abci.VoteInfo{{Validator: validator, SignedLastBlock: false}}
+	s.nextBlock(proposerKey, votes, nil)
+	s.Equal(afterByzantine, delegated(), "not jailed yet")
+	s.nextBlock(proposerKey, votes, nil)
+	data, err := s.k.Get(s.ctx, s.user(2))
+	s.NoError(err)
+	s.True(data.Jailed)
+	s.Equal(afterByzantine-afterByzantine/100, delegated())
+}
+
+func (s *Suite) TestRotateConsKey() {
+	pz := s.k.GetParams(s.ctx)
+	pz.KeyRotationCooldown = 10
+	s.k.SetParams(s.ctx, pz)
+
+	proposerKey := sdk.MustGetPubKeyFromBech32(sdk.Bech32PubKeyTypeConsPub, app.DefaultUser1ConsPubKey)
+	_, oldKey, _ := app.NewTestConsPubAddress()
+	_, newKey, _ := app.NewTestConsPubAddress()
+	oldTmKey, _ := cryptocodec.ToTmProtoPublicKey(oldKey)
+	newTmKey, _ := cryptocodec.ToTmProtoPublicKey(newKey)
+	s.NoError(s.k.SwitchOn(s.ctx, s.user(2), oldKey))
+
+	ebr, _ := s.nextBlock(proposerKey, nil, nil)
+	s.Len(ebr.ValidatorUpdates, 1)
+	power := ebr.ValidatorUpdates[0].Power
+	validator := abci.Validator{Address: oldKey.Address().Bytes(), Power: power}
+	s.nextBlock(proposerKey, []abci.VoteInfo{{Validator: validator, SignedLastBlock: true}}, nil)
+	before, err := s.k.Get(s.ctx, s.user(2))
+	s.NoError(err)
+
+	s.True(errors.Is(s.k.RotateConsKey(s.ctx, s.user(2), proposerKey), noding.ErrPubkeyBusy))
+	s.True(errors.Is(s.k.RotateConsKey(s.ctx, s.user(2), oldKey), noding.ErrPubkeyBusy))
+	s.NoError(s.k.RotateConsKey(s.ctx, s.user(2), newKey))
+
+	after, err := s.k.Get(s.ctx, s.user(2))
+	s.NoError(err)
+	s.Equal(before.Score, after.Score)
+	s.Equal(before.OkBlocksInRow, after.OkBlocksInRow)
+	acc, found, active, err := s.k.GetValidatorByConsAddr(s.ctx, sdk.GetConsAddress(newKey))
+	s.NoError(err)
+	s.True(found)
+	s.True(active)
+	s.Equal(s.user(2), acc)
+
+	ebr, _ = s.nextBlock(proposerKey, []abci.VoteInfo{{Validator: validator, SignedLastBlock: true}}, nil)
+	s.Equal([]abci.ValidatorUpdate{
+		{PubKey: oldTmKey, Power: 0},
+		{PubKey: newTmKey, Power: power},
+	}, ebr.ValidatorUpdates)
+
+	_, anotherKey, _ := app.NewTestConsPubAddress()
+	s.True(errors.Is(s.k.RotateConsKey(s.ctx, s.user(2), anotherKey), noding.ErrKeyRotationTooSoon))
+}
+
+func (s *Suite) TestPenaltyDecay() {
+	pz := s.k.GetParams(s.ctx)
+	pz.JailAfter = 100
+	pz.PenaltyDecayPeriod = 4
+	pz.PenaltyDecayAmount = 1
+	s.k.SetParams(s.ctx, pz)
+
+	proposerKey := sdk.MustGetPubKeyFromBech32(sdk.Bech32PubKeyTypeConsPub, app.DefaultUser1ConsPubKey)
+	_, pubkey, _ := app.NewTestConsPubAddress()
+	s.NoError(s.k.SwitchOn(s.ctx, s.user(2), pubkey))
+	validator := abci.Validator{
+		Address: pubkey.Address().Bytes(),
+		Power:   10,
+	}
+	for s.ctx.BlockHeight()%4 != 2 {
+		s.nextBlock(proposerKey, nil, nil)
+	}
+
+	score, delegation, uptime, penalty, err := s.k.GetScoreBreakdown(s.ctx, s.user(2))
+	s.NoError(err)
+	s.Equal(int64(0), penalty)
+	s.Equal(int64(0), uptime)
+	s.Equal(delegation, score)
+
+	votes := []abci.VoteInfo{{Validator: validator, SignedLastBlock: false}}
+	s.nextBlock(proposerKey, votes, nil)
+	s.nextBlock(proposerKey, votes, nil)
+	score, delegation, _, penalty, err = s.k.GetScoreBreakdown(s.ctx, s.user(2))
+	s.NoError(err)
+	s.Equal(int64(1), penalty, "the 1st stroke is forgiven immediately")
+	s.Equal(delegation-1, score)
+
+	s.nextBlock(proposerKey, nil, nil)
+	s.nextBlock(proposerKey, nil, nil)
+	s.nextBlock(proposerKey, nil, nil)
+	_, _, _, penalty, err = s.k.GetScoreBreakdown(s.ctx, s.user(2))
+	s.NoError(err)
+	s.Equal(int64(1), penalty, "the next decay isn't yet")
+
+	s.nextBlock(proposerKey, nil, nil)
+	score, delegation, _, penalty, err = s.k.GetScoreBreakdown(s.ctx, s.user(2))
+	s.NoError(err)
+	s.Equal(int64(0), penalty)
+	s.Equal(delegation, score)
+}
+
+func (s *Suite) TestValidatorSetHistory() {
+	proposerKey := sdk.MustGetPubKeyFromBech32(sdk.Bech32PubKeyTypeConsPub, app.DefaultUser1ConsPubKey)
+	_, pubkey, _ := app.NewTestConsPubAddress()
+	members := func(height int64) []string {
+		set, err := s.k.GetValidatorSetAt(s.ctx, height)
+		s.NoError(err)
+		var result []string
+		for _, m := range set {
+			result = append(result, m.Account)
+		}
+		return result
+	}
+
+	h0 := s.ctx.BlockHeight()
+	s.nextBlock(proposerKey, nil, nil)
+	s.NoError(s.k.SwitchOn(s.ctx, s.user(2), pubkey))
+	s.nextBlock(proposerKey, nil, nil)
+	s.NoError(s.k.SwitchOff(s.ctx, s.user(2)))
+	s.nextBlock(proposerKey, nil, nil)
+
+	s.Equal([]string{s.user(1).String()}, members(h0))
+	s.ElementsMatch([]string{s.user(1).String(), s.user(2).String()}, members(h0+1))
+	s.Equal([]string{s.user(1).String()}, members(h0+2))
+	s.Equal([]string{s.user(1).String()}, members(s.ctx.BlockHeight()))
+
+	_, err := s.k.GetValidatorSetAt(s.ctx, h0-1)
+	s.True(errors.Is(err, noding.ErrNoValidatorSetHistory), err)
+}
+
 func (s *Suite) TestStatusDowngrade() {
 	proposerKey := sdk.MustGetPubKeyFromBech32(sdk.Bech32PubKeyTypeConsPub, app.DefaultUser1ConsPubKey)
 	tmPubKey, _ := cryptocodec.ToTmProtoPublicKey(proposerKey)
@@ -481,6 +829,95 @@ func (s *BaseSuite) TearDownTest() {
 	}
 }
 
+func (s *Suite) TestPauseResume() {
+	pz := s.k.GetParams(s.ctx)
+	pz.MaxPauseHours = 24
+	s.k.SetParams(s.ctx, pz)
+
+	proposerKey := sdk.MustGetPubKeyFromBech32(sdk.Bech32PubKeyTypeConsPub, app.DefaultUser1ConsPubKey)
+	_, pubkey, _ := app.NewTestConsPubAddress()
+	tmPubKey, _ := cryptocodec.ToTmProtoPublicKey(pubkey)
+	s.NoError(s.k.SwitchOn(s.ctx, s.user(2), pubkey))
+
+	ebr, _ := s.nextBlock(proposerKey, nil, nil)
+	s.Len(ebr.ValidatorUpdates, 1)
+	power := ebr.ValidatorUpdates[0].Power
+	validator := abci.Validator{Address: pubkey.Address().Bytes(), Power: power}
+	votes := []abci.VoteInfo{{Validator: validator, SignedLastBlock: true}}
+	s.nextBlock(proposerKey, votes, nil)
+
+	s.True(errors.Is(s.k.Pause(s.ctx, s.user(2), 25), noding.ErrPauseTooLong))
+	s.True(errors.Is(s.k.Resume(s.ctx, s.user(2)), noding.ErrNotPaused))
+	s.True(errors.Is(s.k.Pause(s.ctx, s.user(3), 1), noding.ErrNotFound))
+
+	before, err := s.k.Get(s.ctx, s.user(2))
+	s.NoError(err)
+	s.NoError(s.k.Pause(s.ctx, s.user(2), 1))
+	s.Equal(noding.ValidatorStatePaused, s.k.GetValidatorState(s.ctx, s.user(2)))
+	s.True(errors.Is(s.k.Pause(s.ctx, s.user(2), 1), noding.ErrNotActive))
+
+	ebr, _ = s.nextBlock(proposerKey, votes, nil)
+	s.Equal([]abci.ValidatorUpdate{{PubKey: tmPubKey, Power: 0}}, ebr.ValidatorUpdates)
+	s.nextBlock(proposerKey, []abci.VoteInfo{{Validator: validator, SignedLastBlock: false}}, nil)
+
+	after, err := s.k.Get(s.ctx, s.user(2))
+	s.NoError(err)
+	s.Equal(before.Score, after.Score)
+	s.Equal(before.OkBlocksInRow, after.OkBlocksInRow)
+	s.Equal(int64(0), after.MissedBlocksInRow)
+
+	s.NoError(s.k.Resume(s.ctx, s.user(2)))
+	ebr, _ = s.nextBlock(proposerKey, nil, nil)
+	s.Equal([]abci.ValidatorUpdate{{PubKey: tmPubKey, Power: power}}, ebr.ValidatorUpdates)
+
+	s.NoError(s.k.Pause(s.ctx, s.user(2), 1))
+	info, err := s.k.Get(s.ctx, s.user(2))
+	s.NoError(err)
+	s.NotNil(info.PausedUntil)
+	s.ctx = s.ctx.WithBlockTime(info.PausedUntil.Add(-30 * time.Second))
+	s.nextBlock(proposerKey, nil, nil)
+	s.NotEqual(noding.ValidatorStatePaused, s.k.GetValidatorState(s.ctx, s.user(2)))
+	info, err = s.k.Get(s.ctx, s.user(2))
+	s.NoError(err)
+	s.Nil(info.PausedUntil)
+}
+
+func (s *Suite) TestEligibility() {
+	e, err := s.k.GetEligibility(s.ctx, s.user(2))
+	s.NoError(err)
+	s.True(e.Eligible)
+	s.True(e.Qualified)
+	s.Empty(e.Reasons)
+	s.True(e.Status.Met)
+	s.True(e.SelfStake.Met)
+	s.True(e.TotalStake.Met)
+
+	e, err = s.k.GetEligibility(s.ctx, s.user(15))
+	s.NoError(err)
+	s.False(e.Eligible)
+	s.False(e.Qualified)
+	s.NotEmpty(e.Reasons)
+	qualified, _, reason, err := s.k.IsQualified(s.ctx, s.user(15))
+	s.NoError(err)
+	s.False(qualified)
+	s.Contains(e.Reasons, reason)
+	for _, c := range []noding.Eligibility_StakeCriterion{e.SelfStake, e.TotalStake} {
+		if c.Met {
+			s.Zero(c.Shortfall)
+		} else {
+			s.Equal(c.Target-c.Current, c.Shortfall)
+		}
+	}
+
+	s.NoError(s.k.AddToStaff(s.ctx, s.user(15), "staff", "", 0))
+	e, err = s.k.GetEligibility(s.ctx, s.user(15))
+	s.NoError(err)
+	s.True(e.Staff)
+	s.True(e.Qualified)
+	s.True(e.Eligible)
+	s.NotEmpty(e.Reasons)
+}
+
 func (s *BaseSuite) nextBlock(proposer crypto.PubKey, votes []abci.VoteInfo, byzantine []abci.Evidence) (abci.ResponseEndBlock, abci.ResponseBeginBlock) {
 	ebr := s.app.EndBlocker(s.ctx, abci.RequestEndBlock{Height: s.ctx.BlockHeight()})
 
@@ -2,10 +2,12 @@ package keeper
 
 import (
 	"encoding/binary"
+	"sort"
 
 	"github.com/pkg/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/arterynetwork/artr/x/noding/types"
 )
@@ -48,12 +50,16 @@ func (k Keeper) lotteryLastNo(ctx sdk.Context, count int) uint64 {
 	store := ctx.KVStore(k.indexStoreKey)
 	key := make([]byte, len(IdxPrefixLotteryQueue)+8)
 	it := sdk.KVStorePrefixIterator(store, IdxPrefixLotteryQueue)
-	for i := 0; i < count; i++ {
+	for i := 0; i < count; it.Next() {
 		if !it.Valid() {
 			break
 		}
+		// Paused validators keep their place in the queue, but don't take a lucky slot
+		if data, err := k.Get(ctx, it.Value()); err == nil && data.PausedUntil != nil {
+			continue
+		}
 		copy(key, it.Key())
-		it.Next()
+		i++
 	}
 	it.Close()
 	return binary.BigEndian.Uint64(key[len(IdxPrefixLotteryQueue):])
@@ -75,3 +81,136 @@ func (k Keeper) lotteryKey(n uint64) []byte {
 	binary.BigEndian.PutUint64(key[len(IdxPrefixLotteryQueue):], n)
 	return key
 }
+
+// luckyOnes returns up to `slots` lucky validators out of spare ones. The winners of the last random draw keep their
+// slots as long as they are spare. A new draw (seeded by the previous block hash) is made only if some slot is vacated,
+// and it fills the vacated slots only, by weighted random sampling. The draw input is stored, so anyone can recompute it
+// later.
+func (k Keeper) luckyOnes(ctx sdk.Context, spare []types.InfoWithAccount, slots int) map[string]bool {
+	if slots == 0 {
+		return nil
+	}
+
+	isSpare := make(map[string]bool, len(spare))
+	for _, data := range spare {
+		isSpare[data.Account.String()] = true
+	}
+
+	lucky := make(map[string]bool, slots)
+	var kept []string
+	if last, _, err := k.GetLotteryDraw(ctx, 0); err == nil {
+		for _, acc := range last.Lucky() {
+			if isSpare[acc] {
+				kept = append(kept, acc)
+				lucky[acc] = true
+			}
+		}
+	}
+	if len(kept) > slots {
+		// There are fewer slots now, so let's start it all over again
+		kept = nil
+		lucky = make(map[string]bool, slots)
+	}
+	if len(kept) == slots || len(kept) == len(spare) {
+		return lucky
+	}
+
+	draw := types.LotteryDraw{
+		Seed:       ctx.BlockHeader().LastBlockId.Hash,
+		Slots:      uint32(slots - len(kept)),
+		Candidates: make([]types.LotteryCandidate, 0, len(spare)-len(kept)),
+		Kept:       kept,
+	}
+	for _, data := range spare {
+		if lucky[data.Account.String()] {
+			continue
+		}
+		draw.Candidates = append(draw.Candidates, types.LotteryCandidate{
+			Account: data.Account.String(),
+			Weight:  types.LotteryWeight(data.Score),
+		})
+	}
+	sort.Slice(draw.Candidates, func(i, j int) bool { return draw.Candidates[i].Account < draw.Candidates[j].Account })
+	k.saveLotteryDraw(ctx, draw)
+
+	for _, acc := range draw.Winners() {
+		lucky[acc] = true
+	}
+	return lucky
+}
+
+// saveLotteryDraw stores a new draw and deletes old ones the lottery_draw_retention param doesn't allow to keep.
+func (k Keeper) saveLotteryDraw(ctx sdk.Context, draw types.LotteryDraw) {
+	store := ctx.KVStore(k.indexStoreKey)
+	store.Set(lotteryDrawKey(ctx.BlockHeight()), k.cdc.MustMarshalBinaryBare(&draw))
+
+	retention := int(k.GetParams(ctx).LotteryDrawRetention)
+	if retention == 0 {
+		retention = 1
+	}
+	var keys [][]byte
+	it := sdk.KVStoreReversePrefixIterator(store, IdxPrefixLotteryDraw)
+	for i := 0; it.Valid(); it.Next() {
+		if i++; i > retention {
+			keys = append(keys, it.Key())
+		}
+	}
+	it.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetLotteryDraw returns a random lottery draw made at the specified height (or the last one if the height is 0).
+func (k Keeper) GetLotteryDraw(ctx sdk.Context, height int64) (types.LotteryDraw, int64, error) {
+	store := ctx.KVStore(k.indexStoreKey)
+	var bz []byte
+	if height == 0 {
+		it := sdk.KVStoreReversePrefixIterator(store, IdxPrefixLotteryDraw)
+		if it.Valid() {
+			height = int64(binary.BigEndian.Uint64(it.Key()[len(IdxPrefixLotteryDraw):]))
+			bz = it.Value()
+		}
+		it.Close()
+	} else {
+		bz = store.Get(lotteryDrawKey(height))
+	}
+	if bz == nil {
+		return types.LotteryDraw{}, 0, sdkerrors.Wrapf(types.ErrNoLotteryDraw, "height %d", height)
+	}
+
+	var draw types.LotteryDraw
+	k.cdc.MustUnmarshalBinaryBare(bz, &draw)
+	return draw, height, nil
+}
+
+// GetLotteryDraws returns all the random lottery draws kept in the store (for genesis export).
+func (k Keeper) GetLotteryDraws(ctx sdk.Context) []types.LotteryDrawRecord {
+	var result []types.LotteryDrawRecord
+	it := sdk.KVStorePrefixIterator(ctx.KVStore(k.indexStoreKey), IdxPrefixLotteryDraw)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		record := types.LotteryDrawRecord{
+			Height: int64(binary.BigEndian.Uint64(it.Key()[len(IdxPrefixLotteryDraw):])),
+		}
+		k.cdc.MustUnmarshalBinaryBare(it.Value(), &record.Draw)
+		result = append(result, record)
+	}
+	return result
+}
+
+// SetLotteryDraws stores random lottery draws (on genesis import).
+func (k Keeper) SetLotteryDraws(ctx sdk.Context, draws []types.LotteryDrawRecord) {
+	store := ctx.KVStore(k.indexStoreKey)
+	for _, record := range draws {
+		store.Set(lotteryDrawKey(record.Height), k.cdc.MustMarshalBinaryBare(&record.Draw))
+	}
+}
+
+func lotteryDrawKey(height int64) []byte {
+	key := make([]byte, len(IdxPrefixLotteryDraw)+8)
+	copy(key, IdxPrefixLotteryDraw)
+	binary.BigEndian.PutUint64(key[len(IdxPrefixLotteryDraw):], uint64(height))
+	return key
+}
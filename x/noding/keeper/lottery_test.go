@@ -3,6 +3,7 @@
 package keeper_test
 
 import (
+	"errors"
 	"io/ioutil"
 	"testing"
 
@@ -13,6 +14,8 @@ import (
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	crypto "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/arterynetwork/artr/x/noding"
 )
 
 func TestNodingKeeper_Lottery(t *testing.T) {
@@ -221,6 +224,193 @@ func (s *LotterySuite) TestLotteryNoSeq() {
 	}
 }
 
+func (s *LotterySuite) TestRandomDraw() {
+	params := s.k.GetParams(s.ctx)
+	params.LotteryMode = noding.LotteryModeRandom
+	s.k.SetParams(s.ctx, params)
+
+	header := s.ctx.BlockHeader()
+	header.LastBlockId.Hash = []byte("previous block hash")
+	s.ctx = s.ctx.WithBlockHeader(header)
+	height := s.ctx.BlockHeight()
+
+	resp, _ := s.nextBlock(
+		s.pubKeys[0],
+		s.votes(map[int]bool{0: true, 1: true, 2: true}),
+		nil,
+	)
+
+	draw, h, err := s.k.GetLotteryDraw(s.ctx, 0)
+	s.NoError(err)
+	s.Equal(height, h)
+	s.Equal([]byte("previous block hash"), draw.Seed)
+	s.Equal(uint32(2), draw.Slots)
+	s.Len(draw.Candidates, 4)
+
+	winners := draw.Winners()
+	s.Len(winners, 2)
+	s.NotEqual(winners[0], winners[1])
+	s.Equal(winners, draw.Winners(), "the draw must be reproducible")
+
+	expected := make(map[int]int64)
+	for n := 0; n <= 6; n++ {
+		for _, w := range winners {
+			if s.accAddrs[n].String() == w {
+				expected[n] = 10
+			}
+		}
+	}
+	s.checkUpdates(expected, resp.ValidatorUpdates)
+
+	_, _, err = s.k.GetLotteryDraw(s.ctx, height+1)
+	s.True(errors.Is(err, noding.ErrNoLotteryDraw), err)
+}
+
+func (s *LotterySuite) TestRandomDraw_KeepWinners() {
+	s.nextBlock(
+		s.pubKeys[0],
+		s.votes(map[int]bool{0: true, 1: true, 2: true}),
+		nil,
+	)
+
+	params := s.k.GetParams(s.ctx)
+	params.LotteryMode = noding.LotteryModeRandom
+	params.LotteryDrawRetention = 1
+	s.k.SetParams(s.ctx, params)
+
+	header := s.ctx.BlockHeader()
+	header.LastBlockId.Hash = []byte("previous block hash")
+	s.ctx = s.ctx.WithBlockHeader(header)
+	height := s.ctx.BlockHeight()
+
+	s.nextBlock(
+		s.pubKeys[1],
+		s.votes(map[int]bool{0: true, 1: true, 2: true, 5: true, 6: true}),
+		nil,
+	)
+	draw, _, err := s.k.GetLotteryDraw(s.ctx, height)
+	s.NoError(err)
+	winners := make(map[int]bool)
+	for n := 0; n <= 6; n++ {
+		for _, w := range draw.Winners() {
+			if s.accAddrs[n].String() == w {
+				winners[n] = true
+			}
+		}
+	}
+	s.Len(winners, 2)
+	votes := map[int]bool{0: true, 1: true, 2: true}
+	for n := range winners {
+		votes[n] = true
+	}
+
+	// Nothing happens, so the winners keep their slots
+	header = s.ctx.BlockHeader()
+	header.LastBlockId.Hash = []byte("another block hash")
+	s.ctx = s.ctx.WithBlockHeader(header)
+	resp, _ := s.nextBlock(s.pubKeys[1], s.votes(votes), nil)
+	s.checkUpdates(map[int]int64{}, resp.ValidatorUpdates)
+	_, _, err = s.k.GetLotteryDraw(s.ctx, height+1)
+	s.True(errors.Is(err, noding.ErrNoLotteryDraw), err)
+
+	// One of the winners leaves, so its slot is drawn among the others
+	var gone, stayed int
+	for n := range winners {
+		if gone == 0 {
+			gone = n
+		} else {
+			stayed = n
+		}
+	}
+	s.NoError(s.k.SwitchOff(s.ctx, s.accAddrs[gone]))
+	resp, _ = s.nextBlock(s.pubKeys[2], s.votes(votes), nil)
+
+	draw, h, err := s.k.GetLotteryDraw(s.ctx, 0)
+	s.NoError(err)
+	s.Equal(height+2, h)
+	s.Equal([]byte("another block hash"), draw.Seed)
+	s.Equal(uint32(1), draw.Slots)
+	s.Equal([]string{s.accAddrs[stayed].String()}, draw.Kept)
+	s.Len(draw.Candidates, 2)
+	for _, c := range draw.Candidates {
+		s.NotEqual(s.accAddrs[gone].String(), c.Account)
+		s.NotEqual(s.accAddrs[stayed].String(), c.Account)
+	}
+
+	lucky := draw.Lucky()
+	s.Len(lucky, 2)
+	s.Equal(s.accAddrs[stayed].String(), lucky[0])
+	expected := map[int]int64{gone: 0}
+	for n := 0; n <= 6; n++ {
+		if s.accAddrs[n].String() == lucky[1] {
+			expected[n] = 10
+		}
+	}
+	s.checkUpdates(expected, resp.ValidatorUpdates)
+
+	// Old draws are pruned
+	_, _, err = s.k.GetLotteryDraw(s.ctx, height)
+	s.True(errors.Is(err, noding.ErrNoLotteryDraw), err)
+}
+
+func (s *LotterySuite) TestRandomDraw_FewerSlots() {
+	s.nextBlock(
+		s.pubKeys[0],
+		s.votes(map[int]bool{0: true, 1: true, 2: true}),
+		nil,
+	)
+
+	params := s.k.GetParams(s.ctx)
+	params.LotteryMode = noding.LotteryModeRandom
+	params.LotteryValidators = 4
+	s.k.SetParams(s.ctx, params)
+
+	header := s.ctx.BlockHeader()
+	header.LastBlockId.Hash = []byte("previous block hash")
+	s.ctx = s.ctx.WithBlockHeader(header)
+	height := s.ctx.BlockHeight()
+
+	// Every spare validator wins
+	resp, _ := s.nextBlock(
+		s.pubKeys[1],
+		s.votes(map[int]bool{0: true, 1: true, 2: true, 5: true, 6: true}),
+		nil,
+	)
+	s.checkUpdates(map[int]int64{3: 10, 4: 10}, resp.ValidatorUpdates)
+	draw, _, err := s.k.GetLotteryDraw(s.ctx, height)
+	s.NoError(err)
+	s.Len(draw.Lucky(), 4)
+
+	// There are fewer slots now than kept winners, so the draw starts over
+	params.LotteryValidators = 2
+	s.k.SetParams(s.ctx, params)
+	resp, _ = s.nextBlock(
+		s.pubKeys[2],
+		s.votes(map[int]bool{0: true, 1: true, 2: true, 3: true, 4: true, 5: true, 6: true}),
+		nil,
+	)
+
+	draw, h, err := s.k.GetLotteryDraw(s.ctx, 0)
+	s.NoError(err)
+	s.Equal(height+1, h)
+	s.Equal(uint32(2), draw.Slots)
+	s.Empty(draw.Kept)
+	s.Len(draw.Candidates, 4)
+
+	lucky := draw.Lucky()
+	s.Len(lucky, 2)
+	expected := make(map[int]int64)
+	for n := 3; n <= 6; n++ {
+		expected[n] = 0
+		for _, acc := range lucky {
+			if s.accAddrs[n].String() == acc {
+				delete(expected, n)
+			}
+		}
+	}
+	s.checkUpdates(expected, resp.ValidatorUpdates)
+}
+
 func (s *LotterySuite) votes(data map[int]bool) []abci.VoteInfo {
 	var result []abci.VoteInfo
 	for n, signed := range data {
@@ -41,3 +41,33 @@ func (s MsgServer) Unjail(ctx context.Context, msg *types.MsgUnjail) (*types.Msg
 	}
 	return &types.MsgUnjailResponse{}, nil
 }
+
+func (s MsgServer) RotateConsKey(ctx context.Context, msg *types.MsgRotateConsKey) (*types.MsgRotateConsKeyResponse, error) {
+	k := Keeper(s)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	err := k.RotateConsKey(sdkCtx, msg.GetAccount(), msg.GetPubKey())
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgRotateConsKeyResponse{}, nil
+}
+
+func (s MsgServer) Pause(ctx context.Context, msg *types.MsgPause) (*types.MsgPauseResponse, error) {
+	k := Keeper(s)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	err := k.Pause(sdkCtx, msg.GetAccount(), msg.Hours)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgPauseResponse{}, nil
+}
+
+func (s MsgServer) Resume(ctx context.Context, msg *types.MsgResume) (*types.MsgResumeResponse, error) {
+	k := Keeper(s)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	err := k.Resume(sdkCtx, msg.GetAccount())
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgResumeResponse{}, nil
+}
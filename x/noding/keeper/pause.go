@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/arterynetwork/artr/util"
+	"github.com/arterynetwork/artr/x/noding/types"
+)
+
+// Pause takes an active validator out of the validator set for the specified number of hours. Its score, counters and
+// lottery place are frozen until it's resumed (either explicitly or automatically after the pause is over).
+func (k Keeper) Pause(ctx sdk.Context, acc sdk.AccAddress, hours uint32) error {
+	maxHours := k.GetParams(ctx).MaxPauseHours
+	if maxHours == 0 {
+		return sdkerrors.Wrap(types.ErrPauseTooLong, "pausing is disabled")
+	}
+	if hours > maxHours {
+		return sdkerrors.Wrapf(types.ErrPauseTooLong, "%d hours at most", maxHours)
+	}
+
+	data, err := k.Get(ctx, acc)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "cannot get data for %s", acc.String())
+	}
+	if !data.IsActive() {
+		return types.ErrNotActive
+	}
+
+	until := ctx.BlockTime().Add(time.Duration(hours) * k.scheduleKeeper.OneDay(ctx) / 24)
+	data.PausedUntil = &until
+	if err := k.set(ctx, acc, data); err != nil {
+		return err
+	}
+	k.scheduleKeeper.ScheduleTask(ctx, until, types.ResumeHookName, acc.Bytes())
+
+	util.EmitEvent(ctx,
+		&types.EventValidatorPaused{
+			Address: acc.String(),
+			Until:   until,
+		},
+	)
+	return nil
+}
+
+// Resume brings a paused validator back before its pause is over.
+func (k Keeper) Resume(ctx sdk.Context, acc sdk.AccAddress) error {
+	data, err := k.Get(ctx, acc)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "cannot get data for %s", acc.String())
+	}
+	if data.PausedUntil == nil {
+		return types.ErrNotPaused
+	}
+	k.scheduleKeeper.Delete(ctx, *data.PausedUntil, types.ResumeHookName, acc.Bytes())
+	return k.resume(ctx, acc, data)
+}
+
+// PerformResume is a schedule hook resuming a validator after its pause is over.
+func (k Keeper) PerformResume(ctx sdk.Context, data []byte, _ time.Time) {
+	acc := sdk.AccAddress(data)
+	info, err := k.Get(ctx, acc)
+	if err != nil {
+		panic(err)
+	}
+	if info.PausedUntil == nil || ctx.BlockTime().Before(*info.PausedUntil) {
+		return
+	}
+	if err := k.resume(ctx, acc, info); err != nil {
+		panic(err)
+	}
+}
+
+func (k Keeper) resume(ctx sdk.Context, acc sdk.AccAddress, data types.Info) error {
+	data.PausedUntil = nil
+	if data.Status {
+		q, delegation, reason, err := k.IsQualified(ctx, acc)
+		if err != nil {
+			return err
+		}
+		if q {
+			data.UpdateScore(delegation.Int64())
+		} else {
+			k.Logger(ctx).Info("banishing from validators", "acc", acc, "reason", reason)
+			data.Status = false
+			if data.LotteryNo != 0 {
+				if err := k.lotteryExclude(ctx, &data); err != nil {
+					// should never happen
+					panic(err)
+				}
+			}
+			util.EmitEvent(ctx,
+				&types.EventValidatorBanished{
+					Address: acc.String(),
+					Reason:  reason,
+				},
+			)
+		}
+	}
+	if err := k.set(ctx, acc, data); err != nil {
+		return err
+	}
+
+	util.EmitEvent(ctx,
+		&types.EventValidatorResumed{
+			Address: acc.String(),
+		},
+	)
+	return nil
+}
@@ -175,3 +175,168 @@ func (s QueryServer) State(ctx context.Context, req *types.StateRequest) (resp *
 	}
 	return &types.StateResponse{State: k.GetValidatorState(sdkCtx, addr)}, nil
 }
+
+func (s QueryServer) SigningInfo(ctx context.Context, req *types.SigningInfoRequest) (resp *types.SigningInfoResponse, err error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	k := Keeper(s)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	defer func() {
+		if e := recover(); e != nil {
+			k.Logger(sdkCtx).Error("panic in QueryServer.SigningInfo", "error", e, "request", *req)
+			err = status.Errorf(codes.Internal, "panic: %s", e)
+		}
+	}()
+	addr, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot parse account address: %s", req.Account)
+	}
+
+	resp = &types.SigningInfoResponse{
+		WindowSize: k.GetParams(sdkCtx).SignedBlocksWindow,
+	}
+	if info, found := k.GetSigningInfo(sdkCtx, addr); found && info.WindowSize == resp.WindowSize {
+		resp.Tracked = info.Tracked()
+		resp.Missed = info.MissedCount
+		resp.Signed = resp.Tracked - resp.Missed
+		resp.Window = info.Window()
+	}
+	return resp, nil
+}
+
+func (s QueryServer) LotteryDraw(ctx context.Context, req *types.LotteryDrawRequest) (resp *types.LotteryDrawResponse, err error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	k := Keeper(s)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	defer func() {
+		if e := recover(); e != nil {
+			k.Logger(sdkCtx).Error("panic in QueryServer.LotteryDraw", "error", e, "request", *req)
+			err = status.Errorf(codes.Internal, "panic: %s", e)
+		}
+	}()
+	draw, height, err := k.GetLotteryDraw(sdkCtx, req.Height)
+	if err != nil {
+		return nil, err
+	}
+	return &types.LotteryDrawResponse{
+		Height:  height,
+		Draw:    draw,
+		Winners: draw.Winners(),
+	}, nil
+}
+
+func (s QueryServer) ScoreBreakdown(ctx context.Context, req *types.ScoreBreakdownRequest) (resp *types.ScoreBreakdownResponse, err error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	k := Keeper(s)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	defer func() {
+		if e := recover(); e != nil {
+			k.Logger(sdkCtx).Error("panic in QueryServer.ScoreBreakdown", "error", e, "request", *req)
+			err = status.Errorf(codes.Internal, "panic: %s", e)
+		}
+	}()
+	addr, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot parse account address: %s", req.Account)
+	}
+
+	resp = &types.ScoreBreakdownResponse{}
+	resp.Score, resp.Delegation, resp.Uptime, resp.Penalty, err = k.GetScoreBreakdown(sdkCtx, addr)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s QueryServer) ValidatorSetAt(ctx context.Context, req *types.ValidatorSetAtRequest) (resp *types.ValidatorSetAtResponse, err error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	k := Keeper(s)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	defer func() {
+		if e := recover(); e != nil {
+			k.Logger(sdkCtx).Error("panic in QueryServer.ValidatorSetAt", "error", e, "request", *req)
+			err = status.Errorf(codes.Internal, "panic: %s", e)
+		}
+	}()
+	height := req.Height
+	if height == 0 {
+		height = sdkCtx.BlockHeight()
+	}
+	validators, err := k.GetValidatorSetAt(sdkCtx, height)
+	if err != nil {
+		return nil, err
+	}
+	return &types.ValidatorSetAtResponse{
+		Height:     height,
+		Validators: validators,
+	}, nil
+}
+
+func (s QueryServer) Eligibility(ctx context.Context, req *types.EligibilityRequest) (resp *types.EligibilityResponse, err error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	k := Keeper(s)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	defer func() {
+		if e := recover(); e != nil {
+			k.Logger(sdkCtx).Error("panic in QueryServer.Eligibility", "error", e, "request", *req)
+			err = status.Errorf(codes.Internal, "panic: %s", e)
+		}
+	}()
+	addr, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot parse account address: %s", req.Account)
+	}
+
+	resp = &types.EligibilityResponse{}
+	resp.Eligibility, err = k.GetEligibility(sdkCtx, addr)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s QueryServer) StaffList(ctx context.Context, _ *types.StaffListRequest) (resp *types.StaffListResponse, err error) {
+	k := Keeper(s)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	defer func() {
+		if e := recover(); e != nil {
+			k.Logger(sdkCtx).Error("panic in QueryServer.StaffList", "error", e)
+			err = status.Errorf(codes.Internal, "panic: %s", e)
+		}
+	}()
+	return &types.StaffListResponse{Staff: k.GetStaff(sdkCtx)}, nil
+}
+
+func (s QueryServer) RewardTotals(ctx context.Context, req *types.RewardTotalsRequest) (resp *types.RewardTotalsResponse, err error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	k := Keeper(s)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	defer func() {
+		if e := recover(); e != nil {
+			k.Logger(sdkCtx).Error("panic in QueryServer.RewardTotals", "error", e, "request", *req)
+			err = status.Errorf(codes.Internal, "panic: %s", e)
+		}
+	}()
+	addr, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot parse account address: %s", req.Account)
+	}
+
+	resp = &types.RewardTotalsResponse{}
+	resp.ProposedCount, resp.Kept, resp.Shared, err = k.GetRewardTotals(sdkCtx, addr)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
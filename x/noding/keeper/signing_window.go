@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/arterynetwork/artr/x/noding/types"
+)
+
+// GetSigningInfo returns a validator's sliding window of the last blocks. The second value is false if nothing's tracked
+// for the account.
+func (k Keeper) GetSigningInfo(ctx sdk.Context, acc sdk.AccAddress) (types.SigningInfo, bool) {
+	bz, found := k.getFromIndex(ctx, signingInfoIdxKey(acc))
+	if !found {
+		return types.SigningInfo{}, false
+	}
+	var info types.SigningInfo
+	k.cdc.MustUnmarshalBinaryBare(bz, &info)
+	return info, true
+}
+
+func (k Keeper) setSigningInfo(ctx sdk.Context, acc sdk.AccAddress, info types.SigningInfo) {
+	k.addToIndex(ctx, signingInfoIdxKey(acc), k.cdc.MustMarshalBinaryBare(&info))
+}
+
+func (k Keeper) resetSigningInfo(ctx sdk.Context, acc sdk.AccAddress) {
+	ctx.KVStore(k.indexStoreKey).Delete(signingInfoIdxKey(acc))
+}
+
+// markSigningWindow records a block to the validator's sliding window of the specified size and returns how many blocks
+// of the window are missed. It does nothing if the size is 0 (i.e. the window is switched off by params).
+func (k Keeper) markSigningWindow(ctx sdk.Context, acc sdk.AccAddress, size uint32, missed bool) (missedCount uint32) {
+	if size == 0 {
+		return 0
+	}
+
+	info, found := k.GetSigningInfo(ctx, acc)
+	if !found || info.WindowSize != size {
+		info = types.NewSigningInfo(size)
+	}
+	info.Mark(missed)
+	k.setSigningInfo(ctx, acc, info)
+
+	return info.MissedCount
+}
+
+func signingInfoIdxKey(acc sdk.AccAddress) []byte {
+	pfxLen := len(IdxPrefixSigningInfo)
+	result := make([]byte, pfxLen+len(acc.Bytes()))
+	copy(result[:pfxLen], IdxPrefixSigningInfo)
+	copy(result[pfxLen:], acc.Bytes())
+	return result
+}
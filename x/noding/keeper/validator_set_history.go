@@ -0,0 +1,188 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/arterynetwork/artr/x/noding/types"
+)
+
+// GetValidatorSetAt reconstructs the validator set as it was at the end of the specified block. It starts from the
+// current set and rolls recorded changes back, so it works only for heights the history is kept for.
+func (k Keeper) GetValidatorSetAt(ctx sdk.Context, height int64) ([]types.ValidatorSetMember, error) {
+	if height > ctx.BlockHeight() {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "height %d is in the future", height)
+	}
+	if start := k.validatorSetHistoryStart(ctx); height < start {
+		return nil, sdkerrors.Wrapf(types.ErrNoValidatorSetHistory, "height %d is less than %d", height, start)
+	}
+
+	members := make(map[string]types.ValidatorSetMember)
+	store := ctx.KVStore(k.dataStoreKey)
+	it := store.Iterator(nil, nil)
+	for ; it.Valid(); it.Next() {
+		var data types.Info
+		k.cdc.MustUnmarshalBinaryBare(it.Value(), &data)
+		if data.LastPower == 0 {
+			continue
+		}
+		members[data.LastPubKey] = types.ValidatorSetMember{
+			Account: sdk.AccAddress(it.Key()).String(),
+			PubKey:  data.LastPubKey,
+			Power:   data.LastPower,
+		}
+	}
+	it.Close()
+
+	it = ctx.KVStore(k.indexStoreKey).ReverseIterator(validatorSetChangeKey(height+1, 0), sdk.PrefixEndBytes(IdxPrefixValidatorSetChange))
+	for ; it.Valid(); it.Next() {
+		var change types.ValidatorSetChange
+		k.cdc.MustUnmarshalBinaryBare(it.Value(), &change)
+		if change.OldPower == 0 {
+			delete(members, change.PubKey)
+		} else {
+			members[change.PubKey] = types.ValidatorSetMember{
+				Account: change.Account,
+				PubKey:  change.PubKey,
+				Power:   change.OldPower,
+			}
+		}
+	}
+	it.Close()
+
+	result := make([]types.ValidatorSetMember, 0, len(members))
+	for _, m := range members {
+		result = append(result, m)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Power != result[j].Power {
+			return result[i].Power > result[j].Power
+		}
+		return result[i].PubKey < result[j].PubKey
+	})
+	return result, nil
+}
+
+// PruneValidatorSetHistory deletes validator set changes that are older than the validator_set_history_retention param
+// allows.
+func (k Keeper) PruneValidatorSetHistory(ctx sdk.Context) {
+	retention := int64(k.GetParams(ctx).ValidatorSetHistoryRetention)
+	if retention == 0 || ctx.BlockHeight() <= retention {
+		return
+	}
+	start := ctx.BlockHeight() - retention
+	if start <= k.validatorSetHistoryStart(ctx) {
+		return
+	}
+
+	store := ctx.KVStore(k.indexStoreKey)
+	it := store.Iterator(validatorSetChangeKey(0, 0), validatorSetChangeKey(start+1, 0))
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+	it.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	k.setValidatorSetHistoryStart(ctx, start)
+}
+
+// GetValidatorSetHistory returns the least height the validator set can be reconstructed for and all the changes kept
+// since then (for genesis export).
+func (k Keeper) GetValidatorSetHistory(ctx sdk.Context) (start int64, changes []types.ValidatorSetChange) {
+	it := sdk.KVStorePrefixIterator(ctx.KVStore(k.indexStoreKey), IdxPrefixValidatorSetChange)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var change types.ValidatorSetChange
+		k.cdc.MustUnmarshalBinaryBare(it.Value(), &change)
+		changes = append(changes, change)
+	}
+	return k.validatorSetHistoryStart(ctx), changes
+}
+
+// SetValidatorSetHistory replaces the validator set history with the provided one (on genesis import). The set chosen
+// at the genesis is considered to be the same as before the export, so its changes are not recorded.
+func (k Keeper) SetValidatorSetHistory(ctx sdk.Context, start int64, changes []types.ValidatorSetChange) {
+	store := ctx.KVStore(k.indexStoreKey)
+	var keys [][]byte
+	it := sdk.KVStorePrefixIterator(store, IdxPrefixValidatorSetChange)
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+	it.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	var (
+		height int64
+		n      uint32
+	)
+	for i, change := range changes {
+		if i == 0 || change.Height != height {
+			height, n = change.Height, 0
+		}
+		store.Set(validatorSetChangeKey(change.Height, n), k.cdc.MustMarshalBinaryBare(&change))
+		n++
+	}
+	k.setValidatorSetHistoryStart(ctx, start)
+}
+
+func (k Keeper) saveValidatorSetChanges(ctx sdk.Context, changes []types.ValidatorSetChange) {
+	store := ctx.KVStore(k.indexStoreKey)
+	if !store.Has(IdxKeyValidatorSetHistoryStart) {
+		k.setValidatorSetHistoryStart(ctx, ctx.BlockHeight())
+	}
+	for i, change := range changes {
+		store.Set(validatorSetChangeKey(change.Height, uint32(i)), k.cdc.MustMarshalBinaryBare(&change))
+	}
+}
+
+// validatorSetHistoryStart returns the least height the validator set can be reconstructed for.
+func (k Keeper) validatorSetHistoryStart(ctx sdk.Context) int64 {
+	bz := ctx.KVStore(k.indexStoreKey).Get(IdxKeyValidatorSetHistoryStart)
+	if bz == nil {
+		return ctx.BlockHeight()
+	}
+	return int64(binary.BigEndian.Uint64(bz))
+}
+
+func (k Keeper) setValidatorSetHistoryStart(ctx sdk.Context, height int64) {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	ctx.KVStore(k.indexStoreKey).Set(IdxKeyValidatorSetHistoryStart, bz)
+}
+
+// lastPowerOfCurrentKey returns a validator's power as it's known to Tendermint if its consensus key is not changed
+// since then, and 0 otherwise.
+func lastPowerOfCurrentKey(data types.Info) int64 {
+	if data.PubKey != data.LastPubKey {
+		return 0
+	}
+	return data.LastPower
+}
+
+func powerChangeReason(data types.Info) types.ValidatorSetChangeReason {
+	switch {
+	case len(data.LastPubKey) != 0 && data.PubKey != data.LastPubKey:
+		return types.VALIDATOR_SET_CHANGE_REASON_KEY_ROTATION
+	case data.LastPower == 0:
+		return types.VALIDATOR_SET_CHANGE_REASON_CHOSEN
+	default:
+		return types.VALIDATOR_SET_CHANGE_REASON_POWER
+	}
+}
+
+func validatorSetChangeKey(height int64, n uint32) []byte {
+	pfxLen := len(IdxPrefixValidatorSetChange)
+	key := make([]byte, pfxLen+8+4)
+	copy(key, IdxPrefixValidatorSetChange)
+	binary.BigEndian.PutUint64(key[pfxLen:], uint64(height))
+	binary.BigEndian.PutUint32(key[pfxLen+8:], n)
+	return key
+}
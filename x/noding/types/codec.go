@@ -13,6 +13,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgOn{}, "noding/MsgOn", nil)
 	cdc.RegisterConcrete(&MsgOff{}, "noding/MsgOff", nil)
 	cdc.RegisterConcrete(&MsgUnjail{}, "noding/MsgUnjail", nil)
+	cdc.RegisterConcrete(&MsgRotateConsKey{}, "noding/MsgRotateConsKey", nil)
+	cdc.RegisterConcrete(&MsgPause{}, "noding/MsgPause", nil)
+	cdc.RegisterConcrete(&MsgResume{}, "noding/MsgResume", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -20,6 +23,9 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgOn{},
 		&MsgOff{},
 		&MsgUnjail{},
+		&MsgRotateConsKey{},
+		&MsgPause{},
+		&MsgResume{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -5,11 +5,18 @@ import (
 )
 
 var (
-	ErrNotQualified      = sdkerrors.Register(ModuleName, 1, "account is not qualified for noding")
-	ErrPubkeyBusy        = sdkerrors.Register(ModuleName, 2, "node with this public key is already validator")
-	ErrNotFound          = sdkerrors.Register(ModuleName, 3, "cannot find account data")
-	ErrNotJailed         = sdkerrors.Register(ModuleName, 4, "validator is not jailed")
-	ErrJailPeriodNotOver = sdkerrors.Register(ModuleName, 5, "jail period is not finished yet")
-	ErrBannedForLifetime = sdkerrors.Register(ModuleName, 6, "validator is banned for a lifetime")
-	ErrAlreadyOn         = sdkerrors.Register(ModuleName, 7, "noding is already on")
+	ErrNotQualified          = sdkerrors.Register(ModuleName, 1, "account is not qualified for noding")
+	ErrPubkeyBusy            = sdkerrors.Register(ModuleName, 2, "node with this public key is already validator")
+	ErrNotFound              = sdkerrors.Register(ModuleName, 3, "cannot find account data")
+	ErrNotJailed             = sdkerrors.Register(ModuleName, 4, "validator is not jailed")
+	ErrJailPeriodNotOver     = sdkerrors.Register(ModuleName, 5, "jail period is not finished yet")
+	ErrBannedForLifetime     = sdkerrors.Register(ModuleName, 6, "validator is banned for a lifetime")
+	ErrAlreadyOn             = sdkerrors.Register(ModuleName, 7, "noding is already on")
+	ErrProposerPruned        = sdkerrors.Register(ModuleName, 8, "block proposer index is pruned for this height")
+	ErrNoLotteryDraw         = sdkerrors.Register(ModuleName, 9, "no lottery draw at this height")
+	ErrKeyRotationTooSoon    = sdkerrors.Register(ModuleName, 10, "consensus key rotation cooldown is not over yet")
+	ErrNoValidatorSetHistory = sdkerrors.Register(ModuleName, 11, "validator set history is not available for this height")
+	ErrNotActive             = sdkerrors.Register(ModuleName, 12, "validator is not active")
+	ErrNotPaused             = sdkerrors.Register(ModuleName, 13, "validator is not paused")
+	ErrPauseTooLong          = sdkerrors.Register(ModuleName, 14, "pause duration exceeds the limit")
 )
@@ -5,3 +5,15 @@ func (EventValidatorBanished) XXX_MessageName() string { return "validator_banis
 func (EventValidatorJailed) XXX_MessageName() string { return "validator_jailed" }
 
 func (EventByzantine) XXX_MessageName() string { return "byzantine" }
+
+func (EventConsKeyRotated) XXX_MessageName() string { return "cons_key_rotated" }
+
+func (EventValidatorSlashed) XXX_MessageName() string { return "validator_slashed" }
+
+func (EventValidatorPaused) XXX_MessageName() string { return "validator_paused" }
+
+func (EventValidatorResumed) XXX_MessageName() string { return "validator_resumed" }
+
+func (EventStaffExpired) XXX_MessageName() string { return "staff_expired" }
+
+func (EventProposerRewarded) XXX_MessageName() string { return "proposer_rewarded" }
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	params "github.com/cosmos/cosmos-sdk/x/params/types"
 
@@ -19,6 +21,7 @@ type ParamSubspace interface {
 type ReferralKeeper interface {
 	GetStatus(ctx sdk.Context, acc string) (referral.Status, error)
 	GetDelegatedInNetwork(ctx sdk.Context, acc string, maxDepth int) (sdk.Int, error)
+	GetReferralValidatorFeesForDelegating(ctx sdk.Context, acc string) ([]referral.ReferralValidatorFee, error)
 }
 
 type AccountKeeper interface {
@@ -29,5 +32,13 @@ type BankKeeper interface {
 	GetParams(ctx sdk.Context) bank.Params
 	GetBalance(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
 	BurnAccCoins(ctx sdk.Context, acc sdk.AccAddress, amt sdk.Coins) error
 }
+
+type ScheduleKeeper interface {
+	ScheduleTask(ctx sdk.Context, time time.Time, event string, data []byte)
+	Delete(ctx sdk.Context, time time.Time, event string, payload []byte)
+
+	OneDay(ctx sdk.Context) time.Duration
+}
@@ -21,13 +21,18 @@ func (v Validator) ToInfo(stake int64) Info {
 		OkBlocksInRow:     v.OkBlocksInRow,
 		MissedBlocksInRow: v.MissedBlocksInRow,
 		Jailed:            v.Jailed,
-		Status:            v.Jailed && v.SwitchedOn,
+		Status:            (v.Jailed || v.PausedUntil != nil) && v.SwitchedOn,
 		UnjailAt:          v.UnjailAt,
 		Infractions:       v.Infractions,
 		BannedForLife:     v.Banned,
 		Staff:             v.Staff,
 		ProposedCount:     v.ProposedCount,
 		JailCount:         v.JailCount,
+		KeyRotatedAt:      v.KeyRotatedAt,
+		PausedUntil:       v.PausedUntil,
+		StaffGrant:        v.StaffGrant,
+		RewardTotal:       v.RewardTotal,
+		RewardSharedTotal: v.RewardSharedTotal,
 	}
 	res.UpdateScore(stake)
 	return res
@@ -47,17 +52,32 @@ func GenesisValidatorFromD(acc sdk.AccAddress, info Info, proposedBlocks []uint6
 		Staff:             info.Staff,
 		ProposedCount:     info.ProposedCount,
 		JailCount:         info.JailCount,
-		SwitchedOn:        info.Jailed && info.Status,
+		SwitchedOn:        (info.Jailed || info.PausedUntil != nil) && info.Status,
 		ProposedBlocks:    proposedBlocks,
+		KeyRotatedAt:      info.KeyRotatedAt,
+		PausedUntil:       info.PausedUntil,
+		StaffGrant:        info.StaffGrant,
+		RewardTotal:       info.RewardTotal,
+		RewardSharedTotal: info.RewardSharedTotal,
 	}
 }
 
 // NewGenesisState creates a new GenesisState object
-func NewGenesisState(params Params, active []Validator, nonactive []Validator) *GenesisState {
+func NewGenesisState(
+	params Params,
+	active []Validator,
+	nonactive []Validator,
+	lotteryDraws []LotteryDrawRecord,
+	validatorSetChanges []ValidatorSetChange,
+	validatorSetHistoryStart int64,
+) *GenesisState {
 	return &GenesisState{
-		Params:    params,
-		Active:    active,
-		NonActive: nonactive,
+		Params:                   params,
+		Active:                   active,
+		NonActive:                nonactive,
+		LotteryDraws:             lotteryDraws,
+		ValidatorSetChanges:      validatorSetChanges,
+		ValidatorSetHistoryStart: validatorSetHistoryStart,
 	}
 }
 
@@ -79,6 +99,12 @@ func ValidateGenesis(data GenesisState) error {
 	if err := validateNonActiveValidators(data.NonActive); err != nil {
 		return errors.Wrap(err, "invalid non_active")
 	}
+	if err := validateLotteryDraws(data.LotteryDraws); err != nil {
+		return errors.Wrap(err, "invalid lottery_draws")
+	}
+	if err := validateValidatorSetHistory(data.ValidatorSetHistoryStart, data.ValidatorSetChanges); err != nil {
+		return errors.Wrap(err, "invalid validator_set_changes")
+	}
 	return nil
 }
 
@@ -99,6 +125,9 @@ func validateActiveValidators(v []Validator) error {
 		if val.Banned {
 			return errors.Errorf("invalid validator #%d: banned validator cannot be active", i)
 		}
+		if val.PausedUntil != nil {
+			return errors.Errorf("invalid validator #%d: paused validator cannot be active", i)
+		}
 		if val.ProposedCount < 0 {
 			return errors.Errorf("invalid validator #%d: proposed_count must be non-negative", i)
 		}
@@ -114,6 +143,11 @@ func validateActiveValidators(v []Validator) error {
 		if val.OkBlocksInRow > 0 && val.MissedBlocksInRow > 0 {
 			return errors.Errorf("invalid validator #%d: either OK or missed block counter can be non-zero, not both of them", i)
 		}
+		if val.SigningInfo != nil {
+			if err := val.SigningInfo.Validate(); err != nil {
+				return errors.Wrapf(err, "invalid validator #%d: invalid signing_info", i)
+			}
+		}
 	}
 	return nil
 }
@@ -143,6 +177,60 @@ func validateNonActiveValidators(v []Validator) error {
 		if val.OkBlocksInRow > 0 && val.MissedBlocksInRow > 0 {
 			return errors.Errorf("invalid validator #%d: either OK or missed block counter can be non-zero, not both of them", i)
 		}
+		if val.SigningInfo != nil {
+			if err := val.SigningInfo.Validate(); err != nil {
+				return errors.Wrapf(err, "invalid validator #%d: invalid signing_info", i)
+			}
+		}
+	}
+	return nil
+}
+
+func validateLotteryDraws(v []LotteryDrawRecord) error {
+	for i, record := range v {
+		if i != 0 && record.Height <= v[i-1].Height {
+			return errors.Errorf("invalid draw #%d: heights must be unique and ascending", i)
+		}
+		if len(record.Draw.Seed) == 0 {
+			return errors.Errorf("invalid draw #%d: empty seed", i)
+		}
+		for j, c := range record.Draw.Candidates {
+			if _, err := sdk.AccAddressFromBech32(c.Account); err != nil {
+				return errors.Wrapf(err, "invalid draw #%d: invalid candidate #%d", i, j)
+			}
+			if c.Weight < 1 {
+				return errors.Errorf("invalid draw #%d: candidate #%d weight must be positive", i, j)
+			}
+		}
+		for j, acc := range record.Draw.Kept {
+			if _, err := sdk.AccAddressFromBech32(acc); err != nil {
+				return errors.Wrapf(err, "invalid draw #%d: invalid kept #%d", i, j)
+			}
+		}
+	}
+	return nil
+}
+
+func validateValidatorSetHistory(start int64, v []ValidatorSetChange) error {
+	if start < 0 {
+		return errors.New("validator_set_history_start must be non-negative")
+	}
+	for i, change := range v {
+		if change.Height < start {
+			return errors.Errorf("invalid change #%d: height %d is less than validator_set_history_start", i, change.Height)
+		}
+		if i != 0 && change.Height < v[i-1].Height {
+			return errors.Errorf("invalid change #%d: changes must be ordered by height", i)
+		}
+		if _, err := sdk.AccAddressFromBech32(change.Account); err != nil {
+			return errors.Wrapf(err, "invalid change #%d: invalid account", i)
+		}
+		if _, err := sdk.GetPubKeyFromBech32(sdk.Bech32PubKeyTypeConsPub, change.PubKey); err != nil {
+			return errors.Wrapf(err, "invalid change #%d: invalid pub_key", i)
+		}
+		if change.OldPower < 0 || change.NewPower < 0 {
+			return errors.Errorf("invalid change #%d: power must be non-negative", i)
+		}
 	}
 	return nil
 }
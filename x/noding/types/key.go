@@ -13,4 +13,7 @@ const (
 
 	// QuerierRoute to be used for querierer msgs
 	QuerierRoute = ModuleName
+
+	ResumeHookName      = "noding/resume"
+	StaffExpiryHookName = "noding/staff-expiry"
 )
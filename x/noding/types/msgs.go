@@ -13,6 +13,9 @@ var (
 	_ sdk.Msg = &MsgOn{}
 	_ sdk.Msg = &MsgOff{}
 	_ sdk.Msg = &MsgUnjail{}
+	_ sdk.Msg = &MsgRotateConsKey{}
+	_ sdk.Msg = &MsgPause{}
+	_ sdk.Msg = &MsgResume{}
 )
 
 func (msg MsgOn) GetAccount() sdk.AccAddress {
@@ -43,6 +46,34 @@ func (m MsgUnjail) GetAccount() sdk.AccAddress {
 	return addr
 }
 
+func (m MsgRotateConsKey) GetAccount() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Account)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+func (m MsgRotateConsKey) GetPubKey() crypto.PubKey {
+	return sdk.MustGetPubKeyFromBech32(sdk.Bech32PubKeyTypeConsPub, m.PubKey)
+}
+
+func (m MsgPause) GetAccount() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Account)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+func (m MsgResume) GetAccount() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Account)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
 func NewMsgOn(accAddr sdk.AccAddress, pubKey crypto.PubKey) *MsgOn {
 	return &MsgOn{
 		Account: accAddr.String(),
@@ -62,10 +93,33 @@ func NewMsgUnjail(accAddr sdk.AccAddress) *MsgUnjail {
 	}
 }
 
+func NewMsgRotateConsKey(accAddr sdk.AccAddress, pubKey crypto.PubKey) *MsgRotateConsKey {
+	return &MsgRotateConsKey{
+		Account: accAddr.String(),
+		PubKey:  sdk.MustBech32ifyPubKey(sdk.Bech32PubKeyTypeConsPub, pubKey),
+	}
+}
+
+func NewMsgPause(accAddr sdk.AccAddress, hours uint32) *MsgPause {
+	return &MsgPause{
+		Account: accAddr.String(),
+		Hours:   hours,
+	}
+}
+
+func NewMsgResume(accAddr sdk.AccAddress) *MsgResume {
+	return &MsgResume{
+		Account: accAddr.String(),
+	}
+}
+
 const (
-	SwitchOnConst  = "SwitchOn"
-	SwitchOffConst = "SwitchOff"
-	UnjailConst    = "Unjail"
+	SwitchOnConst      = "SwitchOn"
+	SwitchOffConst     = "SwitchOff"
+	UnjailConst        = "Unjail"
+	RotateConsKeyConst = "RotateConsKey"
+	PauseConst         = "Pause"
+	ResumeConst        = "Resume"
 )
 
 func (MsgOn) Route() string { return RouterKey }
@@ -139,3 +193,78 @@ func (msg MsgUnjail) ValidateBasic() error {
 	}
 	return nil
 }
+
+func (MsgRotateConsKey) Route() string { return RouterKey }
+func (MsgRotateConsKey) Type() string  { return RotateConsKeyConst }
+func (msg MsgRotateConsKey) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.GetAccount()}
+}
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgRotateConsKey) GetSignBytes() []byte {
+	bz, err := proto.Marshal(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// ValidateBasic validity check for the AnteHandler
+func (msg MsgRotateConsKey) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Account); err != nil {
+		return errors.Wrap(err, "invalid account")
+	}
+	if _, err := sdk.GetPubKeyFromBech32(sdk.Bech32PubKeyTypeConsPub, msg.PubKey); err != nil {
+		return errors.Wrap(err, "invalid pub_key")
+	}
+	return nil
+}
+
+func (MsgPause) Route() string { return RouterKey }
+func (MsgPause) Type() string  { return PauseConst }
+func (msg MsgPause) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.GetAccount()}
+}
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgPause) GetSignBytes() []byte {
+	bz, err := proto.Marshal(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// ValidateBasic validity check for the AnteHandler
+func (msg MsgPause) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Account); err != nil {
+		return errors.Wrap(err, "invalid account")
+	}
+	if msg.Hours == 0 {
+		return errors.New("invalid hours: must be positive")
+	}
+	return nil
+}
+
+func (MsgResume) Route() string { return RouterKey }
+func (MsgResume) Type() string  { return ResumeConst }
+func (msg MsgResume) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.GetAccount()}
+}
+
+// GetSignBytes gets the bytes for the message signer to sign on
+func (msg MsgResume) GetSignBytes() []byte {
+	bz, err := proto.Marshal(&msg)
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// ValidateBasic validity check for the AnteHandler
+func (msg MsgResume) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Account); err != nil {
+		return errors.Wrap(err, "invalid account")
+	}
+	return nil
+}
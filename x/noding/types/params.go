@@ -23,6 +23,18 @@ const (
 	DefaultMinStatus         = referral.StatusLeader
 	DefaultMinSelfStake      = 10_000_000000
 	DefaultMinTotalStake     = 50_000_000000
+
+	DefaultSignedBlocksWindow       = 0
+	DefaultMaxMissedBlocksPerWindow = 0
+	DefaultProposerIndexRetention   = util.BlocksOneMonth
+	DefaultLotteryMode              = LOTTERY_MODE_QUEUE
+	DefaultKeyRotationCooldown      = util.BlocksOneDay
+	DefaultPenaltyDecayPeriod       = 0
+	DefaultPenaltyDecayAmount       = 0
+
+	DefaultValidatorSetHistoryRetention = util.BlocksOneMonth
+	DefaultMaxPauseHours                = 72
+	DefaultLotteryDrawRetention         = 1000
 )
 
 // Parameter store keys
@@ -46,6 +58,12 @@ var (
 		LuckiesVotingPower: 10,
 	}
 
+	// Slashing is off by default, the governance is to turn it on.
+	DefaultSlashFractionDoubleSign = util.FractionZero()
+	DefaultSlashFractionDowntime   = util.FractionZero()
+
+	DefaultProposerRewardUplineShare = util.FractionZero()
+
 	KeyMaxValidators     = []byte("MaxValidators")
 	KeyJailAfter         = []byte("JailAfter")
 	KeyUnjailAfter       = []byte("UnjailAfter")
@@ -53,6 +71,22 @@ var (
 	KeyMinStatus         = []byte("MinStatus")
 	KeyMinCriteria       = []byte("MinCriteria")
 	KeyVotingPower       = []byte("VotingPower")
+
+	KeySignedBlocksWindow       = []byte("SignedBlocksWindow")
+	KeyMaxMissedBlocksPerWindow = []byte("MaxMissedBlocksPerWindow")
+	KeyProposerIndexRetention   = []byte("ProposerIndexRetention")
+	KeySlashFractionDoubleSign  = []byte("SlashFractionDoubleSign")
+	KeySlashFractionDowntime    = []byte("SlashFractionDowntime")
+	KeyLotteryMode              = []byte("LotteryMode")
+	KeyKeyRotationCooldown      = []byte("KeyRotationCooldown")
+	KeyPenaltyDecayPeriod       = []byte("PenaltyDecayPeriod")
+	KeyPenaltyDecayAmount       = []byte("PenaltyDecayAmount")
+
+	KeyValidatorSetHistoryRetention = []byte("ValidatorSetHistoryRetention")
+	KeyMaxPauseHours                = []byte("MaxPauseHours")
+	KeyLotteryDrawRetention         = []byte("LotteryDrawRetention")
+
+	KeyProposerRewardUplineShare = []byte("ProposerRewardUplineShare")
 )
 
 // ParamKeyTable for noding module
@@ -68,6 +102,21 @@ func NewParams(maxValidators, jailAfter, unjailAfter, lotteryValidators uint32,
 		UnjailAfter:       unjailAfter,
 		LotteryValidators: lotteryValidators,
 		MinCriteria:       minCriteria,
+
+		SignedBlocksWindow:       DefaultSignedBlocksWindow,
+		MaxMissedBlocksPerWindow: DefaultMaxMissedBlocksPerWindow,
+		ProposerIndexRetention:   DefaultProposerIndexRetention,
+		SlashFractionDoubleSign:  DefaultSlashFractionDoubleSign,
+		SlashFractionDowntime:    DefaultSlashFractionDowntime,
+		LotteryMode:              DefaultLotteryMode,
+		KeyRotationCooldown:      DefaultKeyRotationCooldown,
+		PenaltyDecayPeriod:       DefaultPenaltyDecayPeriod,
+		PenaltyDecayAmount:       DefaultPenaltyDecayAmount,
+
+		ValidatorSetHistoryRetention: DefaultValidatorSetHistoryRetention,
+		MaxPauseHours:                DefaultMaxPauseHours,
+		ProposerRewardUplineShare:    DefaultProposerRewardUplineShare,
+		LotteryDrawRetention:         DefaultLotteryDrawRetention,
 	}
 }
 
@@ -89,6 +138,19 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		params.NewParamSetPair(KeyLotteryValidators, &p.LotteryValidators, validateAdditionalValidators),
 		params.NewParamSetPair(KeyMinCriteria, &p.MinCriteria, validateMinCriteria),
 		params.NewParamSetPair(KeyVotingPower, &p.VotingPower, validateVotingPower),
+		params.NewParamSetPair(KeySignedBlocksWindow, &p.SignedBlocksWindow, validateSignedBlocksWindow),
+		params.NewParamSetPair(KeyMaxMissedBlocksPerWindow, &p.MaxMissedBlocksPerWindow, validateMaxMissedBlocksPerWindow),
+		params.NewParamSetPair(KeyProposerIndexRetention, &p.ProposerIndexRetention, validateProposerIndexRetention),
+		params.NewParamSetPair(KeySlashFractionDoubleSign, &p.SlashFractionDoubleSign, validateSlashFraction),
+		params.NewParamSetPair(KeySlashFractionDowntime, &p.SlashFractionDowntime, validateSlashFraction),
+		params.NewParamSetPair(KeyLotteryMode, &p.LotteryMode, validateLotteryMode),
+		params.NewParamSetPair(KeyKeyRotationCooldown, &p.KeyRotationCooldown, validateKeyRotationCooldown),
+		params.NewParamSetPair(KeyPenaltyDecayPeriod, &p.PenaltyDecayPeriod, validatePenaltyDecayPeriod),
+		params.NewParamSetPair(KeyPenaltyDecayAmount, &p.PenaltyDecayAmount, validatePenaltyDecayAmount),
+		params.NewParamSetPair(KeyValidatorSetHistoryRetention, &p.ValidatorSetHistoryRetention, validateValidatorSetHistoryRetention),
+		params.NewParamSetPair(KeyMaxPauseHours, &p.MaxPauseHours, validateMaxPauseHours),
+		params.NewParamSetPair(KeyProposerRewardUplineShare, &p.ProposerRewardUplineShare, validateProposerRewardUplineShare),
+		params.NewParamSetPair(KeyLotteryDrawRetention, &p.LotteryDrawRetention, validateLotteryDrawRetention),
 	}
 }
 
@@ -174,6 +236,117 @@ func validateVotingPower(i interface{}) error {
 	return errors.Wrap(distr.Validate(), "invalid voting_power")
 }
 
+func validateSignedBlocksWindow(value interface{}) error {
+	_, ok := value.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid signed_blocks_window type: %T", value)
+	}
+	return nil
+}
+
+func validateMaxMissedBlocksPerWindow(value interface{}) error {
+	_, ok := value.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid max_missed_blocks_per_window type: %T", value)
+	}
+	return nil
+}
+
+func validateProposerIndexRetention(value interface{}) error {
+	_, ok := value.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid proposer_index_retention type: %T", value)
+	}
+	return nil
+}
+
+func validateSlashFraction(value interface{}) error {
+	x, ok := value.(util.Fraction)
+	if !ok {
+		return fmt.Errorf("invalid slash fraction type: %T", value)
+	}
+	if x.IsNullValue() {
+		return nil
+	}
+	if x.IsNegative() || x.GT(util.FractionInt(1)) {
+		return fmt.Errorf("slash fraction must be between 0 and 1: %s", x)
+	}
+	return nil
+}
+
+func validateLotteryMode(value interface{}) error {
+	x, ok := value.(LotteryMode)
+	if !ok {
+		return fmt.Errorf("invalid lottery_mode type: %T", value)
+	}
+	if _, ok := LotteryMode_name[int32(x)]; !ok {
+		return fmt.Errorf("unknown lottery_mode: %d", x)
+	}
+	return nil
+}
+
+func validateKeyRotationCooldown(value interface{}) error {
+	_, ok := value.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid key_rotation_cooldown type: %T", value)
+	}
+	return nil
+}
+
+func validatePenaltyDecayPeriod(value interface{}) error {
+	_, ok := value.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid penalty_decay_period type: %T", value)
+	}
+	return nil
+}
+
+func validatePenaltyDecayAmount(value interface{}) error {
+	_, ok := value.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid penalty_decay_amount type: %T", value)
+	}
+	return nil
+}
+
+func validateValidatorSetHistoryRetention(value interface{}) error {
+	_, ok := value.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid validator_set_history_retention type: %T", value)
+	}
+	return nil
+}
+
+func validateMaxPauseHours(value interface{}) error {
+	_, ok := value.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid max_pause_hours type: %T", value)
+	}
+	return nil
+}
+
+func validateProposerRewardUplineShare(value interface{}) error {
+	x, ok := value.(util.Fraction)
+	if !ok {
+		return fmt.Errorf("invalid proposer_reward_upline_share type: %T", value)
+	}
+	if x.IsNullValue() {
+		return nil
+	}
+	if x.IsNegative() || x.GT(util.FractionInt(1)) {
+		return fmt.Errorf("proposer_reward_upline_share must be between 0 and 1: %s", x)
+	}
+	return nil
+}
+
+func validateLotteryDrawRetention(value interface{}) error {
+	_, ok := value.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid lottery_draw_retention type: %T", value)
+	}
+	return nil
+}
+
 func (p *Params) Validate() error {
 	if p == nil {
 		return fmt.Errorf("params are nil")
@@ -196,5 +369,55 @@ func (p *Params) Validate() error {
 	if err := validateVotingPower(p.VotingPower); err != nil {
 		return err
 	}
+	if err := validateSignedBlocksWindow(p.SignedBlocksWindow); err != nil {
+		return sdkerrors.Wrap(err, "invalid SignedBlocksWindow")
+	}
+	if err := validateMaxMissedBlocksPerWindow(p.MaxMissedBlocksPerWindow); err != nil {
+		return sdkerrors.Wrap(err, "invalid MaxMissedBlocksPerWindow")
+	}
+	if err := validateProposerIndexRetention(p.ProposerIndexRetention); err != nil {
+		return sdkerrors.Wrap(err, "invalid ProposerIndexRetention")
+	}
+	if err := validateSlashFraction(p.SlashFractionDoubleSign); err != nil {
+		return sdkerrors.Wrap(err, "invalid SlashFractionDoubleSign")
+	}
+	if err := validateSlashFraction(p.SlashFractionDowntime); err != nil {
+		return sdkerrors.Wrap(err, "invalid SlashFractionDowntime")
+	}
+	if err := validateLotteryMode(p.LotteryMode); err != nil {
+		return sdkerrors.Wrap(err, "invalid LotteryMode")
+	}
+	if err := validateKeyRotationCooldown(p.KeyRotationCooldown); err != nil {
+		return sdkerrors.Wrap(err, "invalid KeyRotationCooldown")
+	}
+	if err := validatePenaltyDecayPeriod(p.PenaltyDecayPeriod); err != nil {
+		return sdkerrors.Wrap(err, "invalid PenaltyDecayPeriod")
+	}
+	if err := validatePenaltyDecayAmount(p.PenaltyDecayAmount); err != nil {
+		return sdkerrors.Wrap(err, "invalid PenaltyDecayAmount")
+	}
+	if err := validateValidatorSetHistoryRetention(p.ValidatorSetHistoryRetention); err != nil {
+		return sdkerrors.Wrap(err, "invalid ValidatorSetHistoryRetention")
+	}
+	if err := validateMaxPauseHours(p.MaxPauseHours); err != nil {
+		return sdkerrors.Wrap(err, "invalid MaxPauseHours")
+	}
+	if err := validateProposerRewardUplineShare(p.ProposerRewardUplineShare); err != nil {
+		return sdkerrors.Wrap(err, "invalid ProposerRewardUplineShare")
+	}
+	if err := validateLotteryDrawRetention(p.LotteryDrawRetention); err != nil {
+		return sdkerrors.Wrap(err, "invalid LotteryDrawRetention")
+	}
+	if p.MaxMissedBlocksPerWindow != 0 && p.MaxMissedBlocksPerWindow >= p.SignedBlocksWindow {
+		return fmt.Errorf("max_missed_blocks_per_window must be less than signed_blocks_window (%d >= %d)", p.MaxMissedBlocksPerWindow, p.SignedBlocksWindow)
+	}
+	if p.PenaltyDecayPeriod != 0 && p.PenaltyDecayAmount == 0 {
+		return fmt.Errorf("penalty_decay_amount must be positive if penalty_decay_period is set")
+	}
+	if !p.SlashFractionDowntime.IsNullValue() && !p.SlashFractionDowntime.IsZero() &&
+		(p.SlashFractionDoubleSign.IsNullValue() || p.SlashFractionDowntime.GT(p.SlashFractionDoubleSign)) {
+
+		return fmt.Errorf("slash_fraction_downtime must not exceed slash_fraction_double_sign (%s > %s)", p.SlashFractionDowntime, p.SlashFractionDoubleSign)
+	}
 	return nil
 }
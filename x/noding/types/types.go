@@ -1,6 +1,8 @@
 package types
 
 import (
+	"crypto/sha256"
+	"encoding/binary"
 	"fmt"
 	"math"
 
@@ -32,15 +34,11 @@ func NewInfo(pubKey string, delegation int64) *Info {
 }
 
 func (x Info) IsActive() bool {
-	return x.Status && !x.Jailed && !x.BannedForLife
+	return x.Status && !x.Jailed && !x.BannedForLife && x.PausedUntil == nil
 }
 
 func (x *Info) UpdateScore(stake int64) (changed bool) {
-
-	if stake < 1 {
-		stake = 1
-	}
-	score := int64(math.Log(float64(stake))) - x.Strokes + x.OkBlocksInRow/100
+	score := DelegationScore(stake) + x.UptimeScore() - x.PenaltyScore()
 	if score == x.Score {
 		return false
 	}
@@ -48,6 +46,133 @@ func (x *Info) UpdateScore(stake int64) (changed bool) {
 	return true
 }
 
+// DelegationScore - the score component that depends on a validator's delegation (it's a natural logarithm of stake).
+func DelegationScore(stake int64) int64 {
+	if stake < 1 {
+		stake = 1
+	}
+	return int64(math.Log(float64(stake)))
+}
+
+// UptimeScore - the score component that depends on how many blocks a validator has signed in row (+1 per 100 blocks).
+func (x Info) UptimeScore() int64 { return x.OkBlocksInRow / 100 }
+
+// PenaltyScore - the score component (to be subtracted) that depends on how many blocks a validator has missed.
+func (x Info) PenaltyScore() int64 { return x.Strokes }
+
+func NewSigningInfo(windowSize uint32) SigningInfo {
+	return SigningInfo{
+		WindowSize: windowSize,
+		Missed:     make([]byte, (windowSize+7)/8),
+	}
+}
+
+// Mark records the next block to the window, shifting out the oldest one if the window is full.
+func (x *SigningInfo) Mark(missed bool) {
+	var (
+		i    = x.Counter % uint64(x.WindowSize)
+		mask = byte(1) << (i % 8)
+		was  = x.Missed[i/8]&mask != 0
+	)
+	if was && !missed {
+		x.Missed[i/8] &^= mask
+		x.MissedCount--
+	} else if !was && missed {
+		x.Missed[i/8] |= mask
+		x.MissedCount++
+	}
+	x.Counter++
+}
+
+// Tracked returns how many blocks the window actually contains.
+func (x SigningInfo) Tracked() uint32 {
+	if x.Counter < uint64(x.WindowSize) {
+		return uint32(x.Counter)
+	}
+	return x.WindowSize
+}
+
+// Window returns the tracked blocks, from the oldest to the latest one; true means the block was missed.
+func (x SigningInfo) Window() []bool {
+	var (
+		n      = x.Tracked()
+		result = make([]bool, n)
+	)
+	for j := uint32(0); j < n; j++ {
+		i := (x.Counter - uint64(n) + uint64(j)) % uint64(x.WindowSize)
+		result[j] = x.Missed[i/8]&(byte(1)<<(i%8)) != 0
+	}
+	return result
+}
+
+func (x SigningInfo) Validate() error {
+	if x.WindowSize == 0 {
+		return errors.New("window_size must be positive")
+	}
+	if len(x.Missed) != int(x.WindowSize+7)/8 {
+		return errors.Errorf("missed length does not match window_size (%d bytes for %d blocks)", len(x.Missed), x.WindowSize)
+	}
+	var count uint32
+	for _, b := range x.Missed {
+		for ; b != 0; b &= b - 1 {
+			count++
+		}
+	}
+	if count != x.MissedCount {
+		return errors.Errorf("missed_count does not match the bit-array (%d != %d)", x.MissedCount, count)
+	}
+	return nil
+}
+
+// LotteryWeight returns a spare validator's chance to be drawn (in relation to other ones) based on its score.
+func LotteryWeight(score int64) int64 {
+	if score < 1 {
+		return 1
+	}
+	return score
+}
+
+// Lucky returns all the lucky validators chosen as of the draw, i.e. the kept ones followed by the new winners.
+func (x LotteryDraw) Lucky() []string {
+	return append(append(make([]string, 0, len(x.Kept)+int(x.Slots)), x.Kept...), x.Winners()...)
+}
+
+// Winners recomputes the draw. Candidates are picked one by one, each time with a probability proportional to its weight
+// among the remaining ones. The n-th pick is made using the first 8 bytes of SHA-256(Seed || n) as a big-endian random
+// number.
+func (x LotteryDraw) Winners() []string {
+	var (
+		remaining = make([]LotteryCandidate, len(x.Candidates))
+		total     uint64
+		n         = int(x.Slots)
+	)
+	copy(remaining, x.Candidates)
+	for _, c := range remaining {
+		total += uint64(c.Weight)
+	}
+	if n > len(remaining) {
+		n = len(remaining)
+	}
+
+	result := make([]string, 0, n)
+	buf := make([]byte, len(x.Seed)+8)
+	copy(buf, x.Seed)
+	for i := 0; i < n; i++ {
+		binary.BigEndian.PutUint64(buf[len(x.Seed):], uint64(i))
+		hash := sha256.Sum256(buf)
+		r := binary.BigEndian.Uint64(hash[:8]) % total
+
+		j := 0
+		for ; r >= uint64(remaining[j].Weight); j++ {
+			r -= uint64(remaining[j].Weight)
+		}
+		result = append(result, remaining[j].Account)
+		total -= uint64(remaining[j].Weight)
+		remaining = append(remaining[:j], remaining[j+1:]...)
+	}
+	return result
+}
+
 type InfoWithAccount struct {
 	Info
 	Account sdk.AccAddress
@@ -275,10 +275,10 @@ func cmdVpnCurrent() *cobra.Command {
 
 func cmdPayTariff() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "pay_tariff <from_key_or_address> <storage_GBs>",
+		Use:     "pay_tariff <from_key_or_address> <storage_GBs> [months]",
 		Aliases: []string{"pay", "pt", "p"},
-		Short:   "Pay for subscription",
-		Args:    cobra.ExactArgs(2),
+		Short:   "Pay for subscription (for one month unless specified)",
+		Args:    cobra.RangeArgs(2, 3),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			err := cmd.Flags().Set(flags.FlagFrom, args[0])
 			if err != nil {
@@ -297,9 +297,19 @@ func cmdPayTariff() *cobra.Command {
 				storage = uint32(n)
 			}
 
+			var months uint32
+			if len(args) > 2 {
+				if n, err := strconv.ParseUint(args[2], 0, 32); err != nil {
+					return errors.Wrap(err, "cannot parse months")
+				} else {
+					months = uint32(n)
+				}
+			}
+
 			msg := &types.MsgPayTariff{
 				Address:       sender.String(),
 				StorageAmount: storage,
+				Months:        months,
 			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -105,6 +105,10 @@ func (s *Suite) TestParams() {
 		VpnSigners:        []string{app.DefaultGenesisUsers["user7"].String()},
 		TokenRateSigners:  []string{app.DefaultGenesisUsers["user8"].String()},
 		RenamePrice:       1234,
+		SubscriptionDiscounts: []types.SubscriptionDiscount{
+			{Months: 3, Discount: util.Percent(5)},
+			{Months: 12, Discount: util.NewFraction(1, 6)},
+		},
 	})
 	s.checkExportImport()
 }
@@ -14,9 +14,13 @@ import (
 	"github.com/arterynetwork/artr/x/profile/types"
 )
 
-func (k Keeper) PayTariff(ctx sdk.Context, addr sdk.AccAddress, storageGb uint32, isAutoPay bool) error {
+// PayTariff pays for a subscription for the given number of months (zero means one month) in advance.
+func (k Keeper) PayTariff(ctx sdk.Context, addr sdk.AccAddress, storageGb uint32, months uint32, isAutoPay bool) error {
 	p := k.GetParams(ctx)
 	profile := k.GetProfile(ctx, addr)
+	if months == 0 {
+		months = 1
+	}
 
 	var storageB uint64
 	if storageGb == 0 {
@@ -37,7 +41,12 @@ func (k Keeper) PayTariff(ctx sdk.Context, addr sdk.AccAddress, storageGb uint32
 	}
 	// NOTE: We shouldn't use `storageGb` below this point in case it's zero.
 
-	tariffTotal := sdk.NewIntFromBigInt(p.TokenRate.MulInt64(int64(p.SubscriptionPrice)).BigInt())
+	tariffTotal := sdk.NewIntFromBigInt(
+		p.TokenRate.
+			MulInt64(int64(p.SubscriptionPrice) * int64(months)).
+			Mul(util.FractionInt(1).Sub(p.SubscriptionDiscount(months))).
+			BigInt(),
+	)
 
 	txFeeSplitRatios := k.bankKeeper.GetParams(ctx).TransactionFeeSplitRatios
 	txFee := util.CalculateFee(tariffTotal, k.bankKeeper.GetParams(ctx).TransactionFee, k.bankKeeper.GetParams(ctx).MaxTransactionFee, txFeeSplitRatios.ForProposer, txFeeSplitRatios.ForCompany)
@@ -52,7 +61,7 @@ func (k Keeper) PayTariff(ctx sdk.Context, addr sdk.AccAddress, storageGb uint32
 	}
 
 	storageFeeFrac := p.TokenRate.
-		MulInt64((int64(storageB) - int64(p.BaseStorageGb)*util.GBSize) * int64(p.StorageGbPrice)).
+		MulInt64((int64(storageB) - int64(p.BaseStorageGb)*util.GBSize) * int64(p.StorageGbPrice) * int64(months)).
 		DivInt64(util.GBSize).Reduce()
 	if profile.IsActive(ctx) {
 		if storageB != profile.StorageLimit {
@@ -91,8 +100,13 @@ func (k Keeper) PayTariff(ctx sdk.Context, addr sdk.AccAddress, storageGb uint32
 	); err != nil {
 		return errors.Wrap(err, "cannot pay up fees")
 	}
+	var (
+		oneMonth = k.scheduleKeeper.OneMonth(ctx)
+		start    time.Time
+	)
 	if !profile.IsActive(ctx) && !isAutoPay {
-		au := ctx.BlockTime().Add(k.scheduleKeeper.OneMonth(ctx))
+		start = ctx.BlockTime()
+		au := start.Add(time.Duration(months) * oneMonth)
 		profile.ActiveUntil = &au
 		k.resetLimits(ctx, addr, p, profile)
 		util.EmitEvent(ctx,
@@ -102,12 +116,16 @@ func (k Keeper) PayTariff(ctx sdk.Context, addr sdk.AccAddress, storageGb uint32
 			},
 		)
 	} else {
-		*profile.ActiveUntil = profile.ActiveUntil.Add(k.scheduleKeeper.OneMonth(ctx))
+		start = *profile.ActiveUntil
+		*profile.ActiveUntil = start.Add(time.Duration(months) * oneMonth)
 		if isAutoPay {
 			k.resetLimits(ctx, addr, p, profile)
 		}
 	}
-	k.scheduleRenew(ctx, addr, *profile.ActiveUntil)
+	// One task per month, so the limits are reset monthly until the last one finds the subscription over.
+	for i := uint32(1); i <= months; i++ {
+		k.scheduleRenew(ctx, addr, start.Add(time.Duration(i)*oneMonth))
+	}
 	if err := k.SetProfile(ctx, addr, *profile); err != nil {
 		return errors.Wrap(err, "cannot save profile")
 	}
@@ -119,6 +137,7 @@ func (k Keeper) PayTariff(ctx sdk.Context, addr sdk.AccAddress, storageGb uint32
 			CommissionAmount: []uint64{tariffTotal.Uint64()},
 			Total:            total.Uint64(),
 			ExpireAt:         *profile.ActiveUntil,
+			Months:           months,
 		},
 		&bankT.EventTransfer{
 			Sender:    addr.String(),
@@ -410,7 +429,7 @@ func (k Keeper) monthlyRoutine(ctx sdk.Context, addr sdk.AccAddress, time time.T
 	} else {
 		// It's a payday
 		if profile.AutoPay {
-			if err := k.PayTariff(ctx, addr, 0, true); err != nil {
+			if err := k.PayTariff(ctx, addr, 0, 1, true); err != nil {
 				defer k.referralKeeper.MustSetActive(ctx, addr.String(), false)
 				util.EmitEvents(ctx,
 					&types.EventAutoPayFailed{
@@ -77,7 +77,7 @@ func (s *SSuite) TestPayTariffFirstTime() {
 	s.Nil(p.ActiveUntil)
 	s.False(p.IsActive(s.ctx))
 
-	s.NoError(s.k.PayTariff(s.ctx, addr, 0, false))
+	s.NoError(s.k.PayTariff(s.ctx, addr, 0, 1, false))
 
 	p = s.k.GetProfile(s.ctx, addr)
 	s.NotNil(p.ActiveUntil)
@@ -95,7 +95,7 @@ func (s *SSuite) TestPayTariffInAdvance() {
 	s.True(p.IsActive(s.ctx))
 	s.NoError(s.bk.AddCoins(s.ctx, addr, sdk.NewCoins(sdk.NewCoin(util.ConfigMainDenom, sdk.NewInt(1_000_000000)))))
 
-	s.NoError(s.k.PayTariff(s.ctx, addr, 5, false))
+	s.NoError(s.k.PayTariff(s.ctx, addr, 5, 1, false))
 	p = s.k.GetProfile(s.ctx, addr)
 	s.NotNil(p.ActiveUntil)
 	s.Equal(wasPaidUpTo.Add(30*24*time.Hour), *p.ActiveUntil)
@@ -152,7 +152,7 @@ func (s *SSuite) TestPayTariffWhenItIsOver() {
 	s.False(p.AutoPay)
 
 	s.NoError(s.bk.AddCoins(s.ctx, addr, sdk.NewCoins(sdk.NewCoin(util.ConfigMainDenom, sdk.NewInt(1_000_000000)))))
-	s.NoError(s.k.PayTariff(s.ctx, addr, 5, false))
+	s.NoError(s.k.PayTariff(s.ctx, addr, 5, 1, false))
 
 	p = *s.k.GetProfile(s.ctx, addr)
 	s.NotNil(p.ActiveUntil)
@@ -165,7 +165,7 @@ func (s *SSuite) TestPayTariff_ExtraStorage() {
 	s.Equal(uint64((5+13)*util.GBSize), s.k.GetProfile(s.ctx, addr).StorageLimit)
 	balance := s.bk.GetBalance(s.ctx, addr).AmountOf(util.ConfigMainDenom).Int64()
 
-	s.NoError(s.k.PayTariff(s.ctx, addr, 0, false))
+	s.NoError(s.k.PayTariff(s.ctx, addr, 0, 1, false))
 	s.Equal(uint64((5+13)*util.GBSize), s.k.GetProfile(s.ctx, addr).StorageLimit)
 	s.EqualValues(
 		balance-(1990+13*10)*100000,
@@ -173,6 +173,51 @@ func (s *SSuite) TestPayTariff_ExtraStorage() {
 	)
 }
 
+func (s *SSuite) TestPayTariff_Months() {
+	wasPaidUpTo, _ := time.Parse(time.RFC3339, "2022-01-04T03:00:00Z")
+	addr := app.DefaultGenesisUsers["user1"]
+	oneMonth := s.sk.OneMonth(s.ctx)
+
+	params := s.k.GetParams(s.ctx)
+	params.SubscriptionDiscounts = []types.SubscriptionDiscount{
+		{Months: 3, Discount: util.Percent(5)},
+		{Months: 12, Discount: util.Percent(15)},
+	}
+	s.k.SetParams(s.ctx, params)
+	s.NoError(s.bk.AddCoins(s.ctx, addr, util.Uartrs(2_000_000000)))
+	p := *s.k.GetProfile(s.ctx, addr)
+	p.StorageLimit = 5 * util.GBSize
+	s.NoError(s.k.SetProfile(s.ctx, addr, p))
+	balance := s.bk.GetBalance(s.ctx, addr).AmountOf(util.ConfigMainDenom).Int64()
+
+	s.NoError(s.k.PayTariff(s.ctx, addr, 0, 6, false))
+	p = *s.k.GetProfile(s.ctx, addr)
+	s.Equal(wasPaidUpTo.Add(6*oneMonth), *p.ActiveUntil)
+	s.EqualValues(
+		balance-1990*6*95000,
+		s.bk.GetBalance(s.ctx, addr).AmountOf(util.ConfigMainDenom).Int64(),
+	)
+
+	renewals := 0
+	for _, task := range s.sk.GetTasks(s.ctx, wasPaidUpTo.Add(time.Nanosecond), p.ActiveUntil.Add(time.Nanosecond)) {
+		if task.HandlerName == types.RefreshHookName && sdk.AccAddress(task.Data).Equals(addr) {
+			renewals++
+		}
+	}
+	s.Equal(6, renewals)
+
+	// Limits are still reset monthly
+	p.VpnCurrent = 3 * util.GBSize
+	s.NoError(s.k.SetProfile(s.ctx, addr, p))
+	s.ctx = s.ctx.WithBlockHeight(9000).WithBlockTime(wasPaidUpTo.Add(oneMonth))
+	s.nextBlock()
+
+	p = *s.k.GetProfile(s.ctx, addr)
+	s.True(p.IsActive(s.ctx))
+	s.Zero(p.VpnCurrent)
+	s.Equal(wasPaidUpTo.Add(6*oneMonth), *p.ActiveUntil)
+}
+
 func (s *SSuite) TestBuyStorage_TiB() {
 	addr := app.DefaultGenesisUsers["user1"]
 	s.Equal(uint64(0), s.k.GetProfile(s.ctx, addr).StorageLimit)
@@ -40,6 +40,9 @@ const (
 	ProlongImExtraConst    = "prolong_im_extra"
 
 	ForbiddenNicknameCharacters = " */:'\"=[],."
+
+	// MaxTariffMonths is the maximal number of months a subscription can be paid for at once.
+	MaxTariffMonths = 36
 )
 
 func NewMsgCreateAccount(creator sdk.AccAddress, account sdk.AccAddress, referrer sdk.AccAddress) MsgCreateAccount {
@@ -291,6 +294,9 @@ func (msg MsgPayTariff) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
 		return errors.Wrap(err, "invalid address")
 	}
+	if msg.Months > MaxTariffMonths {
+		return errors.Errorf("invalid months: must not exceed %d", MaxTariffMonths)
+	}
 	return nil
 }
 
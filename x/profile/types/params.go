@@ -27,24 +27,26 @@ const (
 
 // Parameter store keys
 var (
-	DefaultCreators         []sdk.AccAddress = nil
-	DefaultStorageSigners   []sdk.AccAddress = nil
-	DefaultVpnSigners       []sdk.AccAddress = nil
-	DefaultTokenRateSigners []sdk.AccAddress = nil
-	DefaultTokenRate                         = util.FractionInt(100000)
-
-	KeyCreators          = []byte("Creators")
-	KeyFee               = []byte("RenamePrice")
-	KeyCardMagic         = []byte("CardMagic")
-	KeyStorageSigners    = []byte("StorageSigners")
-	KeyVpnSigners        = []byte("VpnSigners")
-	KeyTokenRate         = []byte("TokenRate")
-	KeySubscriptionPrice = []byte("SubscriptionPrice")
-	KeyVpnGbPrice        = []byte("VpnGbPrice")
-	KeyStorageGbPrice    = []byte("StorageGbPrice")
-	KeyBaseVpnGb         = []byte("BaseVpnGb")
-	KeyBaseStorageGb     = []byte("BaseStorageGb")
-	KeyTokenRateSigners  = []byte("TokenRateSigners")
+	DefaultCreators              []sdk.AccAddress       = nil
+	DefaultStorageSigners        []sdk.AccAddress       = nil
+	DefaultVpnSigners            []sdk.AccAddress       = nil
+	DefaultTokenRateSigners      []sdk.AccAddress       = nil
+	DefaultTokenRate                                    = util.FractionInt(100000)
+	DefaultSubscriptionDiscounts []SubscriptionDiscount = nil
+
+	KeyCreators              = []byte("Creators")
+	KeyFee                   = []byte("RenamePrice")
+	KeyCardMagic             = []byte("CardMagic")
+	KeyStorageSigners        = []byte("StorageSigners")
+	KeyVpnSigners            = []byte("VpnSigners")
+	KeyTokenRate             = []byte("TokenRate")
+	KeySubscriptionPrice     = []byte("SubscriptionPrice")
+	KeyVpnGbPrice            = []byte("VpnGbPrice")
+	KeyStorageGbPrice        = []byte("StorageGbPrice")
+	KeyBaseVpnGb             = []byte("BaseVpnGb")
+	KeyBaseStorageGb         = []byte("BaseStorageGb")
+	KeyTokenRateSigners      = []byte("TokenRateSigners")
+	KeySubscriptionDiscounts = []byte("SubscriptionDiscounts")
 )
 
 // ParamKeyTable for profile module
@@ -138,16 +140,18 @@ func NewParams(
 	subscriptionPrice uint32,
 	vpnGbPrice, storageGbPrice uint32,
 	baseVpnGb, baseStorageGb uint32,
-	tokenRateSigners []sdk.AccAddress) *Params {
+	tokenRateSigners []sdk.AccAddress,
+	subscriptionDiscounts []SubscriptionDiscount) *Params {
 	p := &Params{
-		RenamePrice:       renamePrice,
-		CardMagic:         cardMagic,
-		TokenRate:         tokenRate,
-		SubscriptionPrice: subscriptionPrice,
-		VpnGbPrice:        vpnGbPrice,
-		StorageGbPrice:    storageGbPrice,
-		BaseVpnGb:         baseVpnGb,
-		BaseStorageGb:     baseStorageGb,
+		RenamePrice:           renamePrice,
+		CardMagic:             cardMagic,
+		TokenRate:             tokenRate,
+		SubscriptionPrice:     subscriptionPrice,
+		VpnGbPrice:            vpnGbPrice,
+		StorageGbPrice:        storageGbPrice,
+		BaseVpnGb:             baseVpnGb,
+		BaseStorageGb:         baseStorageGb,
+		SubscriptionDiscounts: subscriptionDiscounts,
 	}
 	p.SetCreators(creators)
 	p.SetStorageSigners(storageSigners)
@@ -156,6 +160,18 @@ func NewParams(
 	return p
 }
 
+// SubscriptionDiscount returns a discount for a subscription paid for the given number of months in advance.
+func (p Params) SubscriptionDiscount(months uint32) util.Fraction {
+	discount := util.FractionZero()
+	for _, d := range p.SubscriptionDiscounts {
+		if d.Months > months {
+			break
+		}
+		discount = d.Discount
+	}
+	return discount
+}
+
 // String implements the stringer interface for Params
 func (p Params) String() string {
 	out, err := yaml.Marshal(p)
@@ -180,6 +196,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyBaseVpnGb, &p.BaseVpnGb, validatePositive),
 		paramtypes.NewParamSetPair(KeyBaseStorageGb, &p.BaseStorageGb, validatePositive),
 		paramtypes.NewParamSetPair(KeyTokenRateSigners, &p.TokenRateSigners, validateAccounts),
+		paramtypes.NewParamSetPair(KeySubscriptionDiscounts, &p.SubscriptionDiscounts, validateSubscriptionDiscounts),
 	}
 }
 
@@ -198,6 +215,7 @@ func DefaultParams() *Params {
 		DefaultBaseVpnGb,
 		DefaultBaseStorageGb,
 		DefaultTokenRateSigners,
+		DefaultSubscriptionDiscounts,
 	)
 }
 
@@ -238,6 +256,9 @@ func (p Params) Validate() error {
 	if err := validateAccounts(p.TokenRateSigners); err != nil {
 		return errors.Wrap(err, "invaid token_rate_signers")
 	}
+	if err := validateSubscriptionDiscounts(p.SubscriptionDiscounts); err != nil {
+		return errors.Wrap(err, "invalid subscription_discounts")
+	}
 
 	return nil
 }
@@ -301,3 +322,22 @@ func validatePositive(i interface{}) error {
 	}
 	return nil
 }
+
+func validateSubscriptionDiscounts(i interface{}) error {
+	val, ok := i.([]SubscriptionDiscount)
+	if !ok {
+		return errors.Errorf("invalid SubscriptionDiscounts parameter type: %T", i)
+	}
+	for i, d := range val {
+		if d.Months < 2 {
+			return errors.Errorf("item #%d: months must be greater than 1", i)
+		}
+		if i > 0 && d.Months <= val[i-1].Months {
+			return errors.Errorf("item #%d: months must be in ascending order", i)
+		}
+		if d.Discount.IsNullValue() || d.Discount.IsNegative() || d.Discount.GTE(util.FractionInt(1)) {
+			return errors.Errorf("item #%d: discount must be in [0, 1)", i)
+		}
+	}
+	return nil
+}
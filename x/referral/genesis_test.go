@@ -148,7 +148,7 @@ func (s Suite) TestBanished() {
 	for i := 1; i <= 7; i++ {
 		user := fmt.Sprintf("user%d", i)
 		addr := app.DefaultGenesisUsers[user]
-		s.NoError(s.subKeeper.PayTariff(s.ctx, addr, 5, false), "pay tariff for %s (%s)", user, addr.String())
+		s.NoError(s.subKeeper.PayTariff(s.ctx, addr, 5, 1, false), "pay tariff for %s (%s)", user, addr.String())
 	}
 
 	r, err := s.k.Get(s.ctx, user)
@@ -664,7 +664,7 @@ func (s *Suite) TestAddChildAfterReactivation() {
 	s.Zero(len(info.Referrals))
 
 	// Pay tariff
-	s.NoError(s.app.GetProfileKeeper().PayTariff(s.ctx, app.DefaultGenesisUsers["user1"], 5, false))
+	s.NoError(s.app.GetProfileKeeper().PayTariff(s.ctx, app.DefaultGenesisUsers["user1"], 5, 1, false))
 	info, err = s.get(user1)
 	s.NoError(err)
 	s.True(info.Active)
@@ -1042,7 +1042,7 @@ func (s Suite) TestBanishment() {
 	parent := app.DefaultGenesisUsers["user1"]
 
 	s.ctx = s.ctx.WithBlockHeight(9000).WithBlockTime(genesisTime.Add(9000 * 30 * time.Second))
-	s.NoError(s.pk.PayTariff(s.ctx, parent, 5, false))
+	s.NoError(s.pk.PayTariff(s.ctx, parent, 5, 1, false))
 	s.nextBlock()
 
 	info, err := s.get(user)
@@ -1052,7 +1052,7 @@ func (s Suite) TestBanishment() {
 	s.Equal(types.STATUS_LEADER, info.Status)
 
 	s.ctx = s.ctx.WithBlockHeight(9000 + 2*util.BlocksOneMonth).WithBlockTime(genesisTime.Add(9000*30*time.Second + 2*30*24*time.Hour))
-	s.NoError(s.pk.PayTariff(s.ctx, parent, 5, false))
+	s.NoError(s.pk.PayTariff(s.ctx, parent, 5, 1, false))
 	s.nextBlock()
 
 	info, err = s.get(user)
@@ -1064,7 +1064,7 @@ func (s Suite) TestBanishment() {
 	s.NoError(s.dk.Revoke(s.ctx, app.DefaultGenesisUsers["user2"], sdk.NewInt(20_000_000000), false))
 
 	s.ctx = s.ctx.WithBlockHeight(9000 + 3*util.BlocksOneMonth).WithBlockTime(genesisTime.Add(9000*30*time.Second + 3*30*24*time.Hour))
-	s.NoError(s.pk.PayTariff(s.ctx, parent, 5, false))
+	s.NoError(s.pk.PayTariff(s.ctx, parent, 5, 1, false))
 	s.nextBlock()
 
 	info, err = s.get(user)
@@ -1086,7 +1086,7 @@ func (s Suite) TestBanishment_Undelegation() {
 	parent := app.DefaultGenesisUsers["user1"]
 
 	s.ctx = s.ctx.WithBlockHeight(9000).WithBlockTime(genesisTime.Add(9000 * 30 * time.Second))
-	s.NoError(s.pk.PayTariff(s.ctx, parent, 5, false))
+	s.NoError(s.pk.PayTariff(s.ctx, parent, 5, 1, false))
 	s.nextBlock()
 
 	info, err := s.get(user)
@@ -1096,7 +1096,7 @@ func (s Suite) TestBanishment_Undelegation() {
 	s.Equal(types.STATUS_LEADER, info.Status)
 
 	s.ctx = s.ctx.WithBlockHeight(9000 + 2*util.BlocksOneMonth).WithBlockTime(genesisTime.Add(9000*30*time.Second + 2*30*24*time.Hour))
-	s.NoError(s.pk.PayTariff(s.ctx, parent, 5, false))
+	s.NoError(s.pk.PayTariff(s.ctx, parent, 5, 1, false))
 	s.nextBlock()
 
 	info, err = s.get(user)
@@ -1106,7 +1106,7 @@ func (s Suite) TestBanishment_Undelegation() {
 	s.Equal(types.STATUS_LUCKY, info.Status)
 
 	s.ctx = s.ctx.WithBlockHeight(9000 + 3*util.BlocksOneMonth).WithBlockTime(genesisTime.Add(9000*30*time.Second + 3*30*24*time.Hour))
-	s.NoError(s.pk.PayTariff(s.ctx, parent, 5, false))
+	s.NoError(s.pk.PayTariff(s.ctx, parent, 5, 1, false))
 	s.nextBlock()
 
 	info, err = s.get(user)
@@ -1125,7 +1125,7 @@ func (s Suite) TestBanishment_Undelegation() {
 	s.NotNil(info.BanishmentAt)
 
 	s.ctx = s.ctx.WithBlockHeight(9000 + 4*util.BlocksOneMonth).WithBlockTime(genesisTime.Add(9000*30*time.Second + 4*30*24*time.Hour))
-	s.NoError(s.pk.PayTariff(s.ctx, parent, 5, false))
+	s.NoError(s.pk.PayTariff(s.ctx, parent, 5, 1, false))
 	s.nextBlock()
 
 	info, err = s.get(user)
@@ -1143,7 +1143,7 @@ func (s Suite) TestBanishment_DelegationAfterCompression() {
 	parent := app.DefaultGenesisUsers["user1"]
 
 	s.ctx = s.ctx.WithBlockHeight(9000).WithBlockTime(genesisTime.Add(9000 * 30 * time.Second))
-	s.NoError(s.pk.PayTariff(s.ctx, parent, 5, false))
+	s.NoError(s.pk.PayTariff(s.ctx, parent, 5, 1, false))
 	s.nextBlock()
 
 	info, err := s.get(user)
@@ -1155,7 +1155,7 @@ func (s Suite) TestBanishment_DelegationAfterCompression() {
 	s.NoError(s.dk.Revoke(s.ctx, app.DefaultGenesisUsers["user2"], sdk.NewInt(20_000_000000), false))
 
 	s.ctx = s.ctx.WithBlockHeight(9000 + 2*util.BlocksOneMonth).WithBlockTime(genesisTime.Add(9000*30*time.Second + 2*30*24*time.Hour))
-	s.NoError(s.pk.PayTariff(s.ctx, parent, 5, false))
+	s.NoError(s.pk.PayTariff(s.ctx, parent, 5, 1, false))
 	s.nextBlock()
 
 	info, err = s.get(user)
@@ -1166,13 +1166,13 @@ func (s Suite) TestBanishment_DelegationAfterCompression() {
 	s.NotNil(info.BanishmentAt)
 
 	s.ctx = s.ctx.WithBlockHeight(9000 + 2*util.BlocksOneMonth + util.BlocksOneDay).WithBlockTime(genesisTime.Add(9000*30*time.Second + 2*30*24*time.Hour + 24*time.Hour))
-	s.NoError(s.pk.PayTariff(s.ctx, parent, 5, false))
+	s.NoError(s.pk.PayTariff(s.ctx, parent, 5, 1, false))
 	s.nextBlock()
 
 	s.NoError(s.dk.Delegate(s.ctx, app.DefaultGenesisUsers["user2"], sdk.NewInt(1_000_000000)))
 
 	s.ctx = s.ctx.WithBlockHeight(9000 + 3*util.BlocksOneMonth).WithBlockTime(genesisTime.Add(9000*30*time.Second + 3*30*24*time.Hour))
-	s.NoError(s.pk.PayTariff(s.ctx, parent, 5, false))
+	s.NoError(s.pk.PayTariff(s.ctx, parent, 5, 1, false))
 	s.nextBlock()
 
 	info, err = s.get(user)
@@ -1193,15 +1193,15 @@ func (s Suite) TestComeBack() {
 	s.NoError(s.dk.Revoke(s.ctx, app.DefaultGenesisUsers["user2"], sdk.NewInt(20_000_000000), false))
 
 	s.ctx = s.ctx.WithBlockHeight(9000).WithBlockTime(genesisTime.Add(9000 * 30 * time.Second))
-	s.NoError(s.pk.PayTariff(s.ctx, parent, 5, false))
+	s.NoError(s.pk.PayTariff(s.ctx, parent, 5, 1, false))
 	s.nextBlock()
 
 	s.ctx = s.ctx.WithBlockHeight(9000 + 2*util.BlocksOneMonth).WithBlockTime(genesisTime.Add(9000*30*time.Second + 2*30*24*time.Hour))
-	s.NoError(s.pk.PayTariff(s.ctx, parent, 5, false))
+	s.NoError(s.pk.PayTariff(s.ctx, parent, 5, 1, false))
 	s.nextBlock()
 
 	s.ctx = s.ctx.WithBlockHeight(9000 + 3*util.BlocksOneMonth).WithBlockTime(genesisTime.Add(9000*30*time.Second + 3*30*24*time.Hour))
-	s.NoError(s.pk.PayTariff(s.ctx, parent, 5, false))
+	s.NoError(s.pk.PayTariff(s.ctx, parent, 5, 1, false))
 	s.nextBlock()
 
 	info, err := s.get(user)
@@ -1209,10 +1209,10 @@ func (s Suite) TestComeBack() {
 	s.True(info.Banished)
 
 	s.ctx = s.ctx.WithBlockHeight(9000 + 3*util.BlocksOneMonth + util.BlocksOneDay).WithBlockTime(genesisTime.Add(9000*30*time.Second + 3*30*24*time.Hour + 24*time.Hour))
-	s.NoError(s.pk.PayTariff(s.ctx, parent, 5, false))
+	s.NoError(s.pk.PayTariff(s.ctx, parent, 5, 1, false))
 	s.nextBlock()
 
-	s.NoError(s.pk.PayTariff(s.ctx, app.DefaultGenesisUsers["user2"], 5, false))
+	s.NoError(s.pk.PayTariff(s.ctx, app.DefaultGenesisUsers["user2"], 5, 1, false))
 
 	info, err = s.get(user)
 	s.NoError(err)
@@ -1237,17 +1237,17 @@ func (s Suite) TestComeBack_BubbleUp() {
 	)
 
 	s.ctx = s.ctx.WithBlockHeight(9000).WithBlockTime(genesisTime.Add(9000 * 30 * time.Second))
-	s.NoError(s.pk.PayTariff(s.ctx, user1, 5, false))
-	s.NoError(s.pk.PayTariff(s.ctx, user2, 5, false))
-	s.NoError(s.pk.PayTariff(s.ctx, user4, 5, false))
+	s.NoError(s.pk.PayTariff(s.ctx, user1, 5, 1, false))
+	s.NoError(s.pk.PayTariff(s.ctx, user2, 5, 1, false))
+	s.NoError(s.pk.PayTariff(s.ctx, user4, 5, 1, false))
 	s.nextBlock()
 
 	s.ctx = s.ctx.WithBlockHeight(9000 + 2*util.BlocksOneMonth).WithBlockTime(genesisTime.Add(9000*30*time.Second + 2*30*24*time.Hour))
-	s.NoError(s.pk.PayTariff(s.ctx, user1, 5, false))
+	s.NoError(s.pk.PayTariff(s.ctx, user1, 5, 1, false))
 	s.nextBlock()
 
 	s.ctx = s.ctx.WithBlockHeight(9000 + 3*util.BlocksOneMonth).WithBlockTime(genesisTime.Add(9000*30*time.Second + 3*30*24*time.Hour))
-	s.NoError(s.pk.PayTariff(s.ctx, user1, 5, false))
+	s.NoError(s.pk.PayTariff(s.ctx, user1, 5, 1, false))
 	s.nextBlock()
 
 	info, err := s.get(user8.String())
@@ -1256,9 +1256,9 @@ func (s Suite) TestComeBack_BubbleUp() {
 	s.Equal(user4.String(), info.Referrer)
 
 	s.ctx = s.ctx.WithBlockHeight(9000 + 4*util.BlocksOneMonth).WithBlockTime(genesisTime.Add(9000*30*time.Second + 4*30*24*time.Hour))
-	s.NoError(s.pk.PayTariff(s.ctx, user1, 5, false))
+	s.NoError(s.pk.PayTariff(s.ctx, user1, 5, 1, false))
 	s.nextBlock()
-	s.NoError(s.pk.PayTariff(s.ctx, user8, 5, false))
+	s.NoError(s.pk.PayTariff(s.ctx, user8, 5, 1, false))
 
 	info, err = s.get(user8.String())
 	s.NoError(err)
@@ -1349,19 +1349,19 @@ func (s Suite) TestComeBackViaDelegation() {
 	s.NoError(s.dk.Revoke(s.ctx, user, sdk.NewInt(20_000_000000), false))
 
 	s.ctx = s.ctx.WithBlockHeight(8999).WithBlockTime(genesisTime.Add(8999 * 30 * time.Second))
-	s.NoError(s.pk.PayTariff(s.ctx, parent, 5, false))
+	s.NoError(s.pk.PayTariff(s.ctx, parent, 5, 1, false))
 	s.nextBlock()
 
 	s.ctx = s.ctx.
 		WithBlockHeight(8999 + 2*util.BlocksOneMonth).
 		WithBlockTime(genesisTime.Add((8999 + 2*util.BlocksOneMonth) * 30 * time.Second))
-	s.NoError(s.pk.PayTariff(s.ctx, parent, 5, false))
+	s.NoError(s.pk.PayTariff(s.ctx, parent, 5, 1, false))
 	s.nextBlock()
 
 	s.ctx = s.ctx.
 		WithBlockHeight(8999 + 3*util.BlocksOneMonth).
 		WithBlockTime(genesisTime.Add((8999 + 3*util.BlocksOneMonth) * 30 * time.Second))
-	s.NoError(s.pk.PayTariff(s.ctx, parent, 5, false))
+	s.NoError(s.pk.PayTariff(s.ctx, parent, 5, 1, false))
 	s.nextBlock()
 
 	info, err := s.get(user.String())
@@ -1371,7 +1371,7 @@ func (s Suite) TestComeBackViaDelegation() {
 	s.ctx = s.ctx.
 		WithBlockHeight(8999 + 3*util.BlocksOneMonth + util.BlocksOneDay).
 		WithBlockTime(genesisTime.Add((8999 + 3*util.BlocksOneMonth + util.BlocksOneDay) * 30 * time.Second))
-	s.NoError(s.pk.PayTariff(s.ctx, parent, 5, false))
+	s.NoError(s.pk.PayTariff(s.ctx, parent, 5, 1, false))
 	s.nextBlock()
 
 	s.NoError(s.dk.Delegate(s.ctx, user, sdk.NewInt(25_000000)))
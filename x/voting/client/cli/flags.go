@@ -3,6 +3,14 @@ package cli
 const (
 	FlagLimit = "limit"
 	FlagPage  = "page"
+	FlagDays  = "days"
+
+	FlagStakeWeighted = "stake-weighted"
+	FlagOption        = "option"
+	FlagSecret        = "secret"
+	FlagPeriod        = "period"
+	FlagId            = "id"
+	FlagActivateAt    = "activate-at"
 
 	FlagLimitDefault = int(30)
 	FlagPageDefault  = int(1)
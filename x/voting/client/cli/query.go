@@ -28,8 +28,9 @@ func NewQueryCmd() *cobra.Command {
 
 	votingQueryCmd.AddCommand(
 		cmdGovernment(),
-		cmdCurrent(),
+		cmdActive(),
 		cmdHistory(),
+		cmdPendingChanges(),
 		util.LineBreak(),
 		cmdPoll(),
 		cmdPollHistory(),
@@ -96,20 +97,21 @@ func cmdGovernment() *cobra.Command {
 	return cmd
 }
 
-func cmdCurrent() *cobra.Command {
+func cmdPendingChanges() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "current",
-		Short: "Query current proposal and its status: votes given and voters list",
-		Args:  cobra.NoArgs,
+		Use:     "pending-changes",
+		Aliases: []string{"pending"},
+		Short:   "Query agreed proposals waiting for their activation time",
+		Args:    cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
 				return err
 			}
 			queryClient := types.NewQueryClient(clientCtx)
-			req := &types.CurrentRequest{}
+			req := &types.PendingChangesRequest{}
 
-			res, err := queryClient.Current(context.Background(), req)
+			res, err := queryClient.PendingChanges(context.Background(), req)
 			if err != nil {
 				return err
 			}
@@ -121,6 +123,38 @@ func cmdCurrent() *cobra.Command {
 	return cmd
 }
 
+func cmdActive() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "active",
+		Aliases: []string{"current"},
+		Short:   "Query proposals being voted at the moment and their status: votes given and voters list",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			req := &types.ActiveRequest{
+				Limit: int32(viper.GetInt(FlagLimit)),
+				Page:  int32(viper.GetInt(FlagPage)),
+			}
+
+			res, err := queryClient.Active(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return util.PrintConsoleOutput(clientCtx, res)
+		},
+	}
+
+	util.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().Int(FlagLimit, FlagLimitDefault, "Query number of proposals per page returned")
+	cmd.Flags().Int(FlagPage, FlagPageDefault, "Query a specific page of paginated results")
+
+	return cmd
+}
+
 func cmdParams() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "params",
@@ -148,16 +182,21 @@ func cmdParams() *cobra.Command {
 
 func cmdPoll() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "poll",
-		Short: "Query the current public poll and its status",
-		Args:  cobra.NoArgs,
-		RunE: func(cmd *cobra.Command, _ []string) error {
+		Use:   "poll [id]",
+		Short: "Query a public poll and its status, or list all active polls if no ID is specified",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
 				return err
 			}
 			queryClient := types.NewQueryClient(clientCtx)
 			req := &types.PollRequest{}
+			if len(args) > 0 {
+				if req.Id, err = strconv.ParseUint(args[0], 10, 64); err != nil {
+					return errors.Wrap(err, "cannot parse poll ID")
+				}
+			}
 
 			res, err := queryClient.Poll(context.Background(), req)
 			if err != nil {
@@ -198,11 +237,17 @@ func cmdPollHistory() *cobra.Command {
 				}
 			}
 
+			id, err := cmd.Flags().GetUint64(FlagId)
+			if err != nil {
+				return err
+			}
+
 			if res, err := queryClient.PollHistory(
 				context.Background(),
 				&types.PollHistoryRequest{
 					Limit: limit,
 					Page:  page,
+					Id:    id,
 				},
 			); err != nil {
 				return err
@@ -211,6 +256,7 @@ func cmdPollHistory() *cobra.Command {
 			}
 		},
 	}
+	cmd.Flags().Uint64(FlagId, 0, "Query a specific completed poll by its ID")
 	util.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
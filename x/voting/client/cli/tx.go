@@ -1,7 +1,9 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"regexp"
 	"strconv"
 	"strings"
@@ -68,11 +70,26 @@ func NewTxCmd() *cobra.Command {
 		cmdRemoveBlockedSender(),
 		cmdSetRevoke(),
 		cmdSetExpressRevoke(),
+		cmdSetUnjailAfter(),
+		cmdSetRenamePrice(),
+		cmdSetBaseVpnGb(),
+		cmdSetBaseStorageGb(),
+		cmdSetVotingPeriod(),
+		cmdSetPollPeriod(),
+		cmdSetCompanyAccounts(),
+		cmdSetFeeCompanyAccount(),
+		cmdSetProposalDeposit(),
+		cmdMultiChange(),
+		cmdParamChange(),
 		util.LineBreak(),
 		cmdVote(),
+		cmdChangeVote(),
 		util.LineBreak(),
 		cmdStartPoll(),
 		cmdAnswerPoll(),
+		cmdCommitAnswer(),
+		cmdRevealAnswer(),
+		cmdCancelPoll(),
 	)
 
 	return votingTxCmd
@@ -116,12 +133,16 @@ func cmdEnterPrice() *cobra.Command {
 					},
 				},
 			}
+			if err = setActivateAt(cmd, &msg.Proposal); err != nil {
+				return err
+			}
 			if err = msg.ValidateBasic(); err != nil {
 				return err
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	addActivateAtFlag(cmd)
 	util.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -158,12 +179,16 @@ func cmdAddGovernor() *cobra.Command {
 					},
 				},
 			}
+			if err = setActivateAt(cmd, &msg.Proposal); err != nil {
+				return err
+			}
 			if err = msg.ValidateBasic(); err != nil {
 				return err
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	addActivateAtFlag(cmd)
 	util.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -200,12 +225,16 @@ func cmdRemoveGovernor() *cobra.Command {
 					},
 				},
 			}
+			if err = setActivateAt(cmd, &msg.Proposal); err != nil {
+				return err
+			}
 			if err = msg.ValidateBasic(); err != nil {
 				return err
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	addActivateAtFlag(cmd)
 	util.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -249,12 +278,16 @@ func cmdProductVpnBasePrice() *cobra.Command {
 					},
 				},
 			}
+			if err = setActivateAt(cmd, &msg.Proposal); err != nil {
+				return err
+			}
 			if err = msg.ValidateBasic(); err != nil {
 				return err
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	addActivateAtFlag(cmd)
 	util.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -298,12 +331,16 @@ func cmdProductStorageBasePrice() *cobra.Command {
 					},
 				},
 			}
+			if err = setActivateAt(cmd, &msg.Proposal); err != nil {
+				return err
+			}
 			if err = msg.ValidateBasic(); err != nil {
 				return err
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	addActivateAtFlag(cmd)
 	util.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -339,12 +376,16 @@ func cmdAddFreeCreator() *cobra.Command {
 					},
 				},
 			}
+			if err = setActivateAt(cmd, &msg.Proposal); err != nil {
+				return err
+			}
 			if err = msg.ValidateBasic(); err != nil {
 				return err
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	addActivateAtFlag(cmd)
 	util.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -380,12 +421,16 @@ func cmdRemoveFreeCreator() *cobra.Command {
 					},
 				},
 			}
+			if err = setActivateAt(cmd, &msg.Proposal); err != nil {
+				return err
+			}
 			if err = msg.ValidateBasic(); err != nil {
 				return err
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	addActivateAtFlag(cmd)
 	util.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -435,12 +480,16 @@ func cmdUpgradeSoftware() *cobra.Command {
 					},
 				},
 			}
+			if err = setActivateAt(cmd, &msg.Proposal); err != nil {
+				return err
+			}
 			if err = msg.ValidateBasic(); err != nil {
 				return err
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	addActivateAtFlag(cmd)
 	util.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -471,6 +520,9 @@ func cmdCancelSoftwareUpgrade() *cobra.Command {
 					Type:   types.PROPOSAL_TYPE_CANCEL_SOFTWARE_UPGRADE,
 				},
 			}
+			if err = setActivateAt(cmd, &msg.Proposal); err != nil {
+				return err
+			}
 			if err = msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -478,6 +530,7 @@ func cmdCancelSoftwareUpgrade() *cobra.Command {
 
 		},
 	}
+	addActivateAtFlag(cmd)
 	util.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -497,6 +550,11 @@ func cmdStaffValidatorAdd() *cobra.Command {
 				return err
 			}
 
+			days, err := cmd.Flags().GetUint32(FlagDays)
+			if err != nil {
+				return err
+			}
+
 			author := clientCtx.GetFromAddress().String()
 			proposalName := args[1]
 			addr := args[0]
@@ -506,19 +564,25 @@ func cmdStaffValidatorAdd() *cobra.Command {
 					Author: author,
 					Name:   proposalName,
 					Type:   types.PROPOSAL_TYPE_STAFF_VALIDATOR_ADD,
-					Args: &types.Proposal_Address{
-						Address: &types.AddressArgs{
+					Args: &types.Proposal_StaffValidator{
+						StaffValidator: &types.StaffValidatorArgs{
 							Address: addr,
+							Days:    days,
 						},
 					},
 				},
 			}
+			if err = setActivateAt(cmd, &msg.Proposal); err != nil {
+				return err
+			}
 			if err = msg.ValidateBasic(); err != nil {
 				return err
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	cmd.Flags().Uint32(FlagDays, 0, "how many days the staff status lasts (0 means forever)")
+	addActivateAtFlag(cmd)
 	util.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -554,12 +618,16 @@ func cmdStaffValidatorRemove() *cobra.Command {
 					},
 				},
 			}
+			if err = setActivateAt(cmd, &msg.Proposal); err != nil {
+				return err
+			}
 			if err = msg.ValidateBasic(); err != nil {
 				return err
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	addActivateAtFlag(cmd)
 	util.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -595,12 +663,16 @@ func cmdEarningSignerAdd() *cobra.Command {
 					},
 				},
 			}
+			if err = setActivateAt(cmd, &msg.Proposal); err != nil {
+				return err
+			}
 			if err = msg.ValidateBasic(); err != nil {
 				return err
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	addActivateAtFlag(cmd)
 	util.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -636,12 +708,16 @@ func cmdEarningSignerRemove() *cobra.Command {
 					},
 				},
 			}
+			if err = setActivateAt(cmd, &msg.Proposal); err != nil {
+				return err
+			}
 			if err = msg.ValidateBasic(); err != nil {
 				return err
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	addActivateAtFlag(cmd)
 	util.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -677,12 +753,16 @@ func cmdCourseChangeSignerAdd() *cobra.Command {
 					},
 				},
 			}
+			if err = setActivateAt(cmd, &msg.Proposal); err != nil {
+				return err
+			}
 			if err = msg.ValidateBasic(); err != nil {
 				return err
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	addActivateAtFlag(cmd)
 	util.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -718,12 +798,16 @@ func cmdCourseChangeSignerRemove() *cobra.Command {
 					},
 				},
 			}
+			if err = setActivateAt(cmd, &msg.Proposal); err != nil {
+				return err
+			}
 			if err = msg.ValidateBasic(); err != nil {
 				return err
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	addActivateAtFlag(cmd)
 	util.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -759,12 +843,16 @@ func cmdVpnCurrentSignerAdd() *cobra.Command {
 					},
 				},
 			}
+			if err = setActivateAt(cmd, &msg.Proposal); err != nil {
+				return err
+			}
 			if err = msg.ValidateBasic(); err != nil {
 				return err
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	addActivateAtFlag(cmd)
 	util.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -800,12 +888,16 @@ func cmdVpnCurrentSignerRemove() *cobra.Command {
 					},
 				},
 			}
+			if err = setActivateAt(cmd, &msg.Proposal); err != nil {
+				return err
+			}
 			if err = msg.ValidateBasic(); err != nil {
 				return err
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	addActivateAtFlag(cmd)
 	util.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -850,12 +942,16 @@ func cmdAccountTransitionPrice() *cobra.Command {
 					},
 				},
 			}
+			if err = setActivateAt(cmd, &msg.Proposal); err != nil {
+				return err
+			}
 			if err = msg.ValidateBasic(); err != nil {
 				return err
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	addActivateAtFlag(cmd)
 	util.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -900,12 +996,16 @@ func cmdSetMinSend() *cobra.Command {
 					},
 				},
 			}
+			if err = setActivateAt(cmd, &msg.Proposal); err != nil {
+				return err
+			}
 			if err = msg.ValidateBasic(); err != nil {
 				return err
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	addActivateAtFlag(cmd)
 	util.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -950,12 +1050,16 @@ func cmdSetMinDelegate() *cobra.Command {
 					},
 				},
 			}
+			if err = setActivateAt(cmd, &msg.Proposal); err != nil {
+				return err
+			}
 			if err = msg.ValidateBasic(); err != nil {
 				return err
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	addActivateAtFlag(cmd)
 	util.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -1000,12 +1104,16 @@ func cmdSetMaxValidators() *cobra.Command {
 					},
 				},
 			}
+			if err = setActivateAt(cmd, &msg.Proposal); err != nil {
+				return err
+			}
 			if err = msg.ValidateBasic(); err != nil {
 				return err
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	addActivateAtFlag(cmd)
 	util.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -1050,12 +1158,16 @@ func cmdSetLotteryValidators() *cobra.Command {
 					},
 				},
 			}
+			if err = setActivateAt(cmd, &msg.Proposal); err != nil {
+				return err
+			}
 			if err = msg.ValidateBasic(); err != nil {
 				return err
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	addActivateAtFlag(cmd)
 	util.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -1085,12 +1197,16 @@ func cmdGeneralAmnesty() *cobra.Command {
 					Type:   types.PROPOSAL_TYPE_GENERAL_AMNESTY,
 				},
 			}
+			if err = setActivateAt(cmd, &msg.Proposal); err != nil {
+				return err
+			}
 			if err = msg.ValidateBasic(); err != nil {
 				return err
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	addActivateAtFlag(cmd)
 	util.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -1145,12 +1261,16 @@ func cmdSetValidatorMinCriteria() *cobra.Command {
 					},
 				},
 			}
+			if err = setActivateAt(cmd, &msg.Proposal); err != nil {
+				return err
+			}
 			if err = msg.ValidateBasic(); err != nil {
 				return err
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	addActivateAtFlag(cmd)
 	util.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -1194,12 +1314,16 @@ func cmdSetJailAfter() *cobra.Command {
 					},
 				},
 			}
+			if err = setActivateAt(cmd, &msg.Proposal); err != nil {
+				return err
+			}
 			if err = msg.ValidateBasic(); err != nil {
 				return err
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	addActivateAtFlag(cmd)
 	util.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -1244,12 +1368,16 @@ func cmdSetDustDelegation() *cobra.Command {
 					},
 				},
 			}
+			if err = setActivateAt(cmd, &msg.Proposal); err != nil {
+				return err
+			}
 			if err = msg.ValidateBasic(); err != nil {
 				return err
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	addActivateAtFlag(cmd)
 	util.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -1307,12 +1435,16 @@ func cmdSetVotingPower() *cobra.Command {
 					},
 				},
 			}
+			if err = setActivateAt(cmd, &msg.Proposal); err != nil {
+				return err
+			}
 			if err = msg.ValidateBasic(); err != nil {
 				return err
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	addActivateAtFlag(cmd)
 	util.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -1353,12 +1485,16 @@ func cmdSetTransactionFee() *cobra.Command {
 					},
 				},
 			}
+			if err = setActivateAt(cmd, &msg.Proposal); err != nil {
+				return err
+			}
 			if err = msg.ValidateBasic(); err != nil {
 				return err
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	addActivateAtFlag(cmd)
 	util.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -1399,12 +1535,16 @@ func cmdSetMaxTransactionFee() *cobra.Command {
 					},
 				},
 			}
+			if err = setActivateAt(cmd, &msg.Proposal); err != nil {
+				return err
+			}
 			if err = msg.ValidateBasic(); err != nil {
 				return err
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	addActivateAtFlag(cmd)
 	util.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -1450,12 +1590,16 @@ func cmdSetTransactionFeeSplitRatios() *cobra.Command {
 					},
 				},
 			}
+			if err = setActivateAt(cmd, &msg.Proposal); err != nil {
+				return err
+			}
 			if err = msg.ValidateBasic(); err != nil {
 				return err
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	addActivateAtFlag(cmd)
 	util.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -1513,12 +1657,16 @@ func cmdSetAccruePercentageTable() *cobra.Command {
 					},
 				},
 			}
+			if err = setActivateAt(cmd, &msg.Proposal); err != nil {
+				return err
+			}
 			if err = msg.ValidateBasic(); err != nil {
 				return err
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	addActivateAtFlag(cmd)
 	util.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -1554,12 +1702,16 @@ func cmdAddBlockedSender() *cobra.Command {
 					},
 				},
 			}
+			if err = setActivateAt(cmd, &msg.Proposal); err != nil {
+				return err
+			}
 			if err = msg.ValidateBasic(); err != nil {
 				return err
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	addActivateAtFlag(cmd)
 	util.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -1595,12 +1747,16 @@ func cmdRemoveBlockedSender() *cobra.Command {
 					},
 				},
 			}
+			if err = setActivateAt(cmd, &msg.Proposal); err != nil {
+				return err
+			}
 			if err = msg.ValidateBasic(); err != nil {
 				return err
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	addActivateAtFlag(cmd)
 	util.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -1650,12 +1806,16 @@ func cmdSetRevoke() *cobra.Command {
 					},
 				},
 			}
+			if err = setActivateAt(cmd, &msg.Proposal); err != nil {
+				return err
+			}
 			if err = msg.ValidateBasic(); err != nil {
 				return err
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	addActivateAtFlag(cmd)
 	util.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -1705,23 +1865,28 @@ func cmdSetExpressRevoke() *cobra.Command {
 					},
 				},
 			}
+			if err = setActivateAt(cmd, &msg.Proposal); err != nil {
+				return err
+			}
 			if err = msg.ValidateBasic(); err != nil {
 				return err
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	addActivateAtFlag(cmd)
 	util.AddTxFlagsToCmd(cmd)
 	return cmd
 }
 
-func cmdVote() *cobra.Command {
+func cmdSetUnjailAfter() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "vote agree|disagree <voter_key_or_address>",
-		Short: "Vote for/against the current proposal",
-		Args:  cobra.ExactArgs(2),
+		Use:     "set-unjail-after <count> <proposal name> <author key or address>",
+		Aliases: []string{"set_unjail_after", "sua"},
+		Short:   "Propose to set a number of blocks a jailed validator must wait before unjailing",
+		Args:    cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			if err := cmd.Flags().Set(flags.FlagFrom, args[1]); err != nil {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[2]); err != nil {
 				return err
 			}
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -1729,15 +1894,32 @@ func cmdVote() *cobra.Command {
 				return err
 			}
 
-			voter := clientCtx.GetFromAddress().String()
-			agree := strings.ToLower(args[0]) == "agree"
-			if !agree && strings.ToLower(args[0]) != "disagree" {
-				return errors.New("cannot parse aggree/disagree flag")
+			author := clientCtx.GetFromAddress().String()
+			proposalName := args[1]
+
+			var count uint32
+			{
+				n, err := strconv.ParseUint(args[0], 0, 32)
+				if err != nil {
+					return err
+				}
+				count = uint32(n)
 			}
 
-			msg := &types.MsgVote{
-				Voter: voter,
-				Agree: agree,
+			msg := &types.MsgPropose{
+				Proposal: types.Proposal{
+					Author: author,
+					Name:   proposalName,
+					Type:   types.PROPOSAL_TYPE_UNJAIL_AFTER,
+					Args: &types.Proposal_Count{
+						Count: &types.CountArgs{
+							Count: count,
+						},
+					},
+				},
+			}
+			if err = setActivateAt(cmd, &msg.Proposal); err != nil {
+				return err
 			}
 			if err = msg.ValidateBasic(); err != nil {
 				return err
@@ -1745,19 +1927,20 @@ func cmdVote() *cobra.Command {
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	addActivateAtFlag(cmd)
 	util.AddTxFlagsToCmd(cmd)
 	return cmd
 }
 
-func cmdStartPoll() *cobra.Command {
+func cmdSetRenamePrice() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "start-poll <author_key_or_address> validators|status:<status> <name> <text> [quorum]",
-		Aliases: []string{"start_poll", "sp"},
-		Short:   "Start a public poll",
-		Example: `start-poll ivan validators Halving "Should we decrease all awards by a half next Monday?" 2/3`,
-		Args:    cobra.RangeArgs(4, 5),
+		Use:     "set-rename-price <price> <proposal name> <author key or address>",
+		Example: `artrd tx voting set-rename-price 1000000 "1 ARTR per rename" ivan`,
+		Aliases: []string{"set_rename_price", "srp"},
+		Short:   "Propose to change the nickname change price (in uARTR)",
+		Args:    cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			if err := cmd.Flags().Set(flags.FlagFrom, args[0]); err != nil {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[2]); err != nil {
 				return err
 			}
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -1765,67 +1948,105 @@ func cmdStartPoll() *cobra.Command {
 				return err
 			}
 
-			poll := types.Poll{
-				Name:     args[2],
-				Author:   clientCtx.GetFromAddress().String(),
-				Question: args[3],
-			}
+			author := clientCtx.GetFromAddress().String()
+			proposalName := args[1]
 
-			if req := args[1]; req == "validators" {
-				poll.Requirements = &types.Poll_CanValidate{CanValidate: &types.Poll_Unit{}}
-			} else if m := regexp.MustCompile(`/^status:(\d+)|([A-Za-z_]+)$/`).FindStringSubmatch(req); m != nil {
-				var status referral.Status
-				if len(m[1]) > 0 {
-					if s, err := strconv.Atoi(m[1]); err != nil {
-						return errors.Wrap(err, "cannot parse status")
-					} else {
-						status = referral.Status(s)
-					}
-				} else {
-					name := strings.ToUpper(m[2])
-					if !strings.HasPrefix(name, "STATUS_") {
-						name = "STATUS_" + name
-					}
-					if status, err = referral.ParseStatus(name); err != nil {
-						return err
-					}
-					if err = status.Validate(); err != nil {
-						return err
-					}
+			var n int64
+			{
+				var err error
+				n, err = strconv.ParseInt(args[0], 0, 64)
+				if err != nil {
+					return err
 				}
+			}
 
-				poll.Requirements = &types.Poll_MinStatus{MinStatus: status}
-			} else {
-				return errors.New("cannot parse requirements")
+			msg := &types.MsgPropose{
+				Proposal: types.Proposal{
+					Author: author,
+					Name:   proposalName,
+					Type:   types.PROPOSAL_TYPE_RENAME_PRICE,
+					Args: &types.Proposal_MinAmount{
+						MinAmount: &types.MinAmountArgs{
+							MinAmount: n,
+						},
+					},
+				},
+			}
+			if err = setActivateAt(cmd, &msg.Proposal); err != nil {
+				return err
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
 			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	addActivateAtFlag(cmd)
+	util.AddTxFlagsToCmd(cmd)
+	return cmd
+}
 
-			if len(args) > 4 {
-				if q, err := util.ParseFraction(args[4]); err != nil {
-					return errors.Wrap(err, "cannot parse quorum")
-				} else {
-					poll.Quorum = &q
+func cmdSetBaseVpnGb() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-base-vpn-gb <GB> <proposal name> <author key or address>",
+		Aliases: []string{"set_base_vpn_gb", "sbvg"},
+		Short:   "Propose to change the VPN traffic amount included into a subscription (in GB)",
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[2]); err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			author := clientCtx.GetFromAddress().String()
+			proposalName := args[1]
+
+			var count uint32
+			{
+				n, err := strconv.ParseUint(args[0], 0, 32)
+				if err != nil {
+					return err
 				}
+				count = uint32(n)
 			}
 
-			msg := types.MsgStartPoll{Poll: poll}
+			msg := &types.MsgPropose{
+				Proposal: types.Proposal{
+					Author: author,
+					Name:   proposalName,
+					Type:   types.PROPOSAL_TYPE_BASE_VPN_GB,
+					Args: &types.Proposal_Count{
+						Count: &types.CountArgs{
+							Count: count,
+						},
+					},
+				},
+			}
+			if err = setActivateAt(cmd, &msg.Proposal); err != nil {
+				return err
+			}
 			if err = msg.ValidateBasic(); err != nil {
 				return err
 			}
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	addActivateAtFlag(cmd)
 	util.AddTxFlagsToCmd(cmd)
 	return cmd
 }
 
-func cmdAnswerPoll() *cobra.Command {
+func cmdSetBaseStorageGb() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "answer yes|no <respondent_key_or_address>",
-		Aliases: []string{"ans", "a", "answer-poll", "answer_poll"},
-		Short:   "Answer the current public poll",
-		Args:    cobra.ExactArgs(2),
+		Use:     "set-base-storage-gb <GB> <proposal name> <author key or address>",
+		Aliases: []string{"set_base_storage_gb", "sbsg"},
+		Short:   "Propose to change the storage amount included into a subscription (in GB)",
+		Args:    cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			if err := cmd.Flags().Set(flags.FlagFrom, args[1]); err != nil {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[2]); err != nil {
 				return err
 			}
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -1833,23 +2054,797 @@ func cmdAnswerPoll() *cobra.Command {
 				return err
 			}
 
-			var yes bool
-			if ans := strings.ToLower(args[0]); ans == "yes" {
-				yes = true
-			} else if ans != "no" {
-				return errors.New("cannot parse answer")
+			author := clientCtx.GetFromAddress().String()
+			proposalName := args[1]
+
+			var count uint32
+			{
+				n, err := strconv.ParseUint(args[0], 0, 32)
+				if err != nil {
+					return err
+				}
+				count = uint32(n)
 			}
 
-			msg := types.MsgAnswerPoll{
-				Respondent: clientCtx.GetFromAddress().String(),
-				Yes:        yes,
+			msg := &types.MsgPropose{
+				Proposal: types.Proposal{
+					Author: author,
+					Name:   proposalName,
+					Type:   types.PROPOSAL_TYPE_BASE_STORAGE_GB,
+					Args: &types.Proposal_Count{
+						Count: &types.CountArgs{
+							Count: count,
+						},
+					},
+				},
+			}
+			if err = setActivateAt(cmd, &msg.Proposal); err != nil {
+				return err
 			}
 			if err = msg.ValidateBasic(); err != nil {
 				return err
 			}
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	addActivateAtFlag(cmd)
 	util.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+func cmdSetVotingPeriod() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-voting-period <hours> <proposal name> <author key or address>",
+		Aliases: []string{"set_voting_period", "svpd"},
+		Short:   "Propose to change the proposal voting period (in hours)",
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[2]); err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			author := clientCtx.GetFromAddress().String()
+			proposalName := args[1]
+
+			var count uint32
+			{
+				n, err := strconv.ParseUint(args[0], 0, 32)
+				if err != nil {
+					return err
+				}
+				count = uint32(n)
+			}
+
+			msg := &types.MsgPropose{
+				Proposal: types.Proposal{
+					Author: author,
+					Name:   proposalName,
+					Type:   types.PROPOSAL_TYPE_VOTING_PERIOD,
+					Args: &types.Proposal_Count{
+						Count: &types.CountArgs{
+							Count: count,
+						},
+					},
+				},
+			}
+			if err = setActivateAt(cmd, &msg.Proposal); err != nil {
+				return err
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	addActivateAtFlag(cmd)
+	util.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func cmdSetPollPeriod() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-poll-period <hours> <proposal name> <author key or address>",
+		Aliases: []string{"set_poll_period", "sppd"},
+		Short:   "Propose to change the default poll period (in hours)",
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[2]); err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			author := clientCtx.GetFromAddress().String()
+			proposalName := args[1]
+
+			var count uint32
+			{
+				n, err := strconv.ParseUint(args[0], 0, 32)
+				if err != nil {
+					return err
+				}
+				count = uint32(n)
+			}
+
+			msg := &types.MsgPropose{
+				Proposal: types.Proposal{
+					Author: author,
+					Name:   proposalName,
+					Type:   types.PROPOSAL_TYPE_POLL_PERIOD,
+					Args: &types.Proposal_Count{
+						Count: &types.CountArgs{
+							Count: count,
+						},
+					},
+				},
+			}
+			if err = setActivateAt(cmd, &msg.Proposal); err != nil {
+				return err
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	addActivateAtFlag(cmd)
+	util.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func cmdSetCompanyAccounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-company-accounts <subscription account address> <proposal name> <author key or address>",
+		Aliases: []string{"set_company_accounts", "sca"},
+		Short:   "Propose to change the company account collecting subscription payments",
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[2]); err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			author := clientCtx.GetFromAddress().String()
+			proposalName := args[1]
+			addr := args[0]
+
+			msg := &types.MsgPropose{
+				Proposal: types.Proposal{
+					Author: author,
+					Name:   proposalName,
+					Type:   types.PROPOSAL_TYPE_COMPANY_ACCOUNTS,
+					Args: &types.Proposal_CompanyAccounts{
+						CompanyAccounts: &types.CompanyAccountsArgs{
+							CompanyAccounts: &referral.CompanyAccounts{
+								ForSubscription: addr,
+							},
+						},
+					},
+				},
+			}
+			if err = setActivateAt(cmd, &msg.Proposal); err != nil {
+				return err
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	addActivateAtFlag(cmd)
+	util.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func cmdSetFeeCompanyAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-fee-company-account <address> <proposal name> <author key or address>",
+		Aliases: []string{"set_fee_company_account", "sfca"},
+		Short:   "Propose to change the company account collecting its share of transaction fees",
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[2]); err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			author := clientCtx.GetFromAddress().String()
+			proposalName := args[1]
+			addr := args[0]
+
+			msg := &types.MsgPropose{
+				Proposal: types.Proposal{
+					Author: author,
+					Name:   proposalName,
+					Type:   types.PROPOSAL_TYPE_COMPANY_ACCOUNT,
+					Args: &types.Proposal_Address{
+						Address: &types.AddressArgs{
+							Address: addr,
+						},
+					},
+				},
+			}
+			if err = setActivateAt(cmd, &msg.Proposal); err != nil {
+				return err
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	addActivateAtFlag(cmd)
+	util.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func cmdSetProposalDeposit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-proposal-deposit <amount> <proposal name> <author key or address>",
+		Example: `artrd tx voting set-proposal-deposit 10000000 "10 ARTR deposit" ivan`,
+		Aliases: []string{"set_proposal_deposit", "spd"},
+		Short:   "Propose to change the deposit taken from a proposal author (in uARTR, 0 means no deposit)",
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[2]); err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			author := clientCtx.GetFromAddress().String()
+			proposalName := args[1]
+
+			var n int64
+			{
+				var err error
+				n, err = strconv.ParseInt(args[0], 0, 64)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := &types.MsgPropose{
+				Proposal: types.Proposal{
+					Author: author,
+					Name:   proposalName,
+					Type:   types.PROPOSAL_TYPE_PROPOSAL_DEPOSIT,
+					Args: &types.Proposal_MinAmount{
+						MinAmount: &types.MinAmountArgs{
+							MinAmount: n,
+						},
+					},
+				},
+			}
+			if err = setActivateAt(cmd, &msg.Proposal); err != nil {
+				return err
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	addActivateAtFlag(cmd)
+	util.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func cmdMultiChange() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "multi-change <changes JSON file> <proposal name> <author key or address>",
+		Example: `artrd tx voting multi-change changes.json "New fees" ivan`,
+		Aliases: []string{"multi_change", "mc"},
+		Short:   "Propose to apply several changes at once (all or nothing)",
+		Long: `Propose to apply several changes at once (all or nothing).
+
+The file should contain a JSON object with a list of changes, each of them is a proposal with only type and args
+specified, e.g.:
+{"changes": [
+  {"type": "PROPOSAL_TYPE_TRANSACTION_FEE", "portion": {"Fraction": "3/1000"}},
+  {"type": "PROPOSAL_TYPE_TRANSACTION_FEE_SPLIT_RATIOS", "portions": {"Fractions": ["1/2", "1/4"]}}
+]}`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[2]); err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			author := clientCtx.GetFromAddress().String()
+			proposalName := args[1]
+
+			bz, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return errors.Wrap(err, "cannot read changes file")
+			}
+			var changes types.MultiChangeArgs
+			if err := clientCtx.JSONMarshaler.UnmarshalJSON(bz, &changes); err != nil {
+				return errors.Wrap(err, "cannot parse changes file")
+			}
+
+			msg := &types.MsgPropose{
+				Proposal: types.Proposal{
+					Author: author,
+					Name:   proposalName,
+					Type:   types.PROPOSAL_TYPE_MULTI_CHANGE,
+					Args: &types.Proposal_MultiChange{
+						MultiChange: &changes,
+					},
+				},
+			}
+			if err = setActivateAt(cmd, &msg.Proposal); err != nil {
+				return err
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	addActivateAtFlag(cmd)
+	util.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func cmdParamChange() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "param-change <changes JSON file> <proposal name> <author key or address>",
+		Example: `artrd tx voting param-change changes.json "Longer jail" ivan`,
+		Aliases: []string{"param_change", "pc"},
+		Short:   "Propose to change arbitrary module parameters (all or nothing)",
+		Long: `Propose to change arbitrary module parameters (all or nothing).
+
+The file should contain a JSON object with a list of changes. Each change specifies a params subspace (usually the
+module name), a parameter key as it's registered by the module, and a new value in the legacy amino JSON encoding
+(64-bit integers are quoted), e.g.:
+{"changes": [
+  {"subspace": "noding", "key": "JailAfter", "value": 20},
+  {"subspace": "profile", "key": "RenamePrice", "value": "5000000"}
+]}`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[2]); err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			author := clientCtx.GetFromAddress().String()
+			proposalName := args[1]
+
+			bz, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return errors.Wrap(err, "cannot read changes file")
+			}
+			var file struct {
+				Changes []struct {
+					Subspace string          `json:"subspace"`
+					Key      string          `json:"key"`
+					Value    json.RawMessage `json:"value"`
+				} `json:"changes"`
+			}
+			if err := json.Unmarshal(bz, &file); err != nil {
+				return errors.Wrap(err, "cannot parse changes file")
+			}
+			changes := make([]types.ParamChange, len(file.Changes))
+			for i, change := range file.Changes {
+				changes[i] = types.ParamChange{
+					Subspace: change.Subspace,
+					Key:      change.Key,
+					Value:    string(change.Value),
+				}
+			}
+
+			msg := &types.MsgPropose{
+				Proposal: types.Proposal{
+					Author: author,
+					Name:   proposalName,
+					Type:   types.PROPOSAL_TYPE_PARAM_CHANGE,
+					Args: &types.Proposal_ParamChange{
+						ParamChange: &types.ParamChangeArgs{
+							Changes: changes,
+						},
+					},
+				},
+			}
+			if err = setActivateAt(cmd, &msg.Proposal); err != nil {
+				return err
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	addActivateAtFlag(cmd)
+	util.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func cmdVote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote <proposal_id> agree|disagree|abstain|veto <voter_key_or_address>",
+		Short: "Vote for/against an active proposal",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[2]); err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			voter := clientCtx.GetFromAddress().String()
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return errors.Wrap(err, "cannot parse proposal_id")
+			}
+			option, err := parseVoteOption(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgVote{
+				Voter:      voter,
+				Agree:      option == types.VOTE_OPTION_YES,
+				ProposalId: id,
+				Option:     option,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	util.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func cmdChangeVote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "change-vote <proposal_id> agree|disagree|abstain|veto <voter_key_or_address>",
+		Aliases: []string{"change_vote", "cv"},
+		Short:   "Change an already given vote for an active proposal",
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[2]); err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			voter := clientCtx.GetFromAddress().String()
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return errors.Wrap(err, "cannot parse proposal_id")
+			}
+			option, err := parseVoteOption(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgChangeVote{
+				Voter:      voter,
+				Agree:      option == types.VOTE_OPTION_YES,
+				ProposalId: id,
+				Option:     option,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	util.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func parseVoteOption(s string) (types.VoteOption, error) {
+	switch strings.ToLower(s) {
+	case "agree", "yes":
+		return types.VOTE_OPTION_YES, nil
+	case "disagree", "no":
+		return types.VOTE_OPTION_NO, nil
+	case "abstain":
+		return types.VOTE_OPTION_ABSTAIN, nil
+	case "veto":
+		return types.VOTE_OPTION_VETO, nil
+	default:
+		return types.VOTE_OPTION_UNSPECIFIED, errors.Errorf("cannot parse vote option: %s", s)
+	}
+}
+
+func cmdStartPoll() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "start-poll <author_key_or_address> validators|status:<status> <name> <text> [quorum]",
+		Aliases: []string{"start_poll", "sp"},
+		Short:   "Start a public poll",
+		Example: `start-poll ivan validators Halving "Should we decrease all awards by a half next Monday?" 2/3`,
+		Args:    cobra.RangeArgs(4, 5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[0]); err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poll := types.Poll{
+				Name:     args[2],
+				Author:   clientCtx.GetFromAddress().String(),
+				Question: args[3],
+			}
+
+			if req := args[1]; req == "validators" {
+				poll.Requirements = &types.Poll_CanValidate{CanValidate: &types.Poll_Unit{}}
+			} else if m := regexp.MustCompile(`/^status:(\d+)|([A-Za-z_]+)$/`).FindStringSubmatch(req); m != nil {
+				var status referral.Status
+				if len(m[1]) > 0 {
+					if s, err := strconv.Atoi(m[1]); err != nil {
+						return errors.Wrap(err, "cannot parse status")
+					} else {
+						status = referral.Status(s)
+					}
+				} else {
+					name := strings.ToUpper(m[2])
+					if !strings.HasPrefix(name, "STATUS_") {
+						name = "STATUS_" + name
+					}
+					if status, err = referral.ParseStatus(name); err != nil {
+						return err
+					}
+					if err = status.Validate(); err != nil {
+						return err
+					}
+				}
+
+				poll.Requirements = &types.Poll_MinStatus{MinStatus: status}
+			} else {
+				return errors.New("cannot parse requirements")
+			}
+
+			if len(args) > 4 {
+				if q, err := util.ParseFraction(args[4]); err != nil {
+					return errors.Wrap(err, "cannot parse quorum")
+				} else {
+					poll.Quorum = &q
+				}
+			}
+
+			if poll.StakeWeighted, err = cmd.Flags().GetBool(FlagStakeWeighted); err != nil {
+				return err
+			}
+			if poll.Options, err = cmd.Flags().GetStringArray(FlagOption); err != nil {
+				return err
+			}
+			if poll.Secret, err = cmd.Flags().GetBool(FlagSecret); err != nil {
+				return err
+			}
+			if poll.Period, err = cmd.Flags().GetInt32(FlagPeriod); err != nil {
+				return err
+			}
+
+			msg := types.MsgStartPoll{Poll: poll}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	cmd.Flags().Bool(FlagStakeWeighted, false, "weight answers by the respondents' delegated stake as of the poll start")
+	cmd.Flags().StringArray(FlagOption, nil, "a custom answer option (repeat for each one) to make a multi-choice poll")
+	cmd.Flags().Int32(FlagPeriod, 0, "a custom poll duration in hours (within the min_poll_period and max_poll_period params)")
+	cmd.Flags().Bool(FlagSecret, false, "make a secret-ballot poll (answers are committed as hashes and revealed after the poll end)")
+	util.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func cmdAnswerPoll() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "answer <poll_id> yes|no|<option_number> <respondent_key_or_address>",
+		Aliases: []string{"ans", "a", "answer-poll", "answer_poll"},
+		Short:   "Answer a public poll",
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[2]); err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pollId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return errors.Wrap(err, "cannot parse poll ID")
+			}
+			yes, option, err := parsePollAnswer(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgAnswerPoll{
+				Respondent: clientCtx.GetFromAddress().String(),
+				Yes:        yes,
+				Option:     option,
+				PollId:     pollId,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	util.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func cmdCommitAnswer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "commit-answer <poll_id> yes|no|<option_number> <salt> <respondent_key_or_address>",
+		Aliases: []string{"commit", "commit_answer"},
+		Short:   "Commit a hash of an answer to a secret poll",
+		Long:    "Commit a hash of an answer to a secret poll. Keep the salt: it's needed to reveal the answer after the poll end.",
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[3]); err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pollId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return errors.Wrap(err, "cannot parse poll ID")
+			}
+			yes, option, err := parsePollAnswer(args[1])
+			if err != nil {
+				return err
+			}
+			respondent := clientCtx.GetFromAddress().String()
+
+			msg := types.MsgCommitAnswer{
+				Respondent: respondent,
+				Hash:       types.PollCommitmentHash(respondent, yes, option, args[2]),
+				PollId:     pollId,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	util.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func cmdRevealAnswer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "reveal-answer <poll_id> yes|no|<option_number> <salt> <respondent_key_or_address>",
+		Aliases: []string{"reveal", "reveal_answer"},
+		Short:   "Reveal an answer committed to a secret poll",
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[3]); err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pollId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return errors.Wrap(err, "cannot parse poll ID")
+			}
+			yes, option, err := parsePollAnswer(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgRevealAnswer{
+				Respondent: clientCtx.GetFromAddress().String(),
+				Yes:        yes,
+				Option:     option,
+				Salt:       args[2],
+				PollId:     pollId,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	util.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func cmdCancelPoll() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cancel-poll <poll_id> <signer_key_or_address>",
+		Aliases: []string{"cancel_poll", "cp"},
+		Short:   "Cancel a poll",
+		Long:    "Cancel a poll. Its author cancels it at once, other government members vote for its cancellation, and it's cancelled as soon as they are a majority.",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmd.Flags().Set(flags.FlagFrom, args[1]); err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pollId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return errors.Wrap(err, "cannot parse poll ID")
+			}
+
+			msg := types.MsgCancelPoll{
+				Signer: clientCtx.GetFromAddress().String(),
+				PollId: pollId,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	util.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func parsePollAnswer(s string) (yes bool, option uint32, err error) {
+	if ans := strings.ToLower(s); ans == "yes" {
+		yes = true
+	} else if n, err := strconv.ParseUint(ans, 10, 32); err == nil && n > 0 {
+		option = uint32(n)
+	} else if ans != "no" {
+		return false, 0, errors.New("cannot parse answer")
+	}
+	return yes, option, nil
+}
+
+func addActivateAtFlag(cmd *cobra.Command) {
+	cmd.Flags().String(FlagActivateAt, "", "a time (RFC 3339) to apply the proposal at if it's agreed (by default, it's applied as soon as the voting is over)")
+}
+
+func setActivateAt(cmd *cobra.Command, proposal *types.Proposal) error {
+	s, err := cmd.Flags().GetString(FlagActivateAt)
+	if err != nil || s == "" {
+		return err
+	}
+	stamp, err := runtime.Timestamp(fmt.Sprintf(`"%s"`, s))
+	if err != nil {
+		return errors.Wrap(err, "cannot parse activation time")
+	}
+	t := stamp.AsTime()
+	proposal.ActivateAt = &t
+	return nil
+}
@@ -15,19 +15,7 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
 	k.Logger(ctx).Info("Starting from genesis...")
 	k.SetParams(ctx, data.Params)
 	k.SetGovernment(ctx, types.Government{Members: data.Government})
-	if data.CurrentProposal.Type != types.PROPOSAL_TYPE_UNSPECIFIED {
-		k.SetCurrentProposal(ctx, data.CurrentProposal)
-		k.SetStartBlock(ctx.WithBlockHeight(data.StartBlock))
-	}
-	if len(data.Agreed) > 0 {
-		k.SetAgreed(ctx, types.Government{Members: data.Agreed})
-	}
-	if len(data.Disagreed) > 0 {
-		k.SetDisagreed(ctx, types.Government{Members: data.Disagreed})
-	}
-	for _, record := range data.History {
-		k.AddProposalHistoryRecord(ctx, record)
-	}
+	k.LoadProposals(ctx, data)
 	k.LoadPolls(ctx, data)
 }
 
@@ -35,24 +23,28 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
 // to a genesis file, which can be imported again
 // with InitGenesis
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) (data *types.GenesisState) {
-	var currentProposal *types.Proposal
-	var startBlock int64
-	if currentProposal = k.GetCurrentProposal(ctx); currentProposal != nil {
-		startBlock = k.GetStartBlock(ctx)
-	}
 	data = types.NewGenesisState(
 		k.GetParams(ctx),
 		k.GetGovernment(ctx),
-		currentProposal,
-		startBlock,
-		k.GetAgreed(ctx),
-		k.GetDisagreed(ctx),
+		k.GetActiveProposals(ctx, 0, 0),
+		k.GetNextProposalId(ctx),
 		k.GetHistory(ctx, math.MaxInt32, 1),
 	)
-	if poll, ok := k.GetCurrentPoll(ctx); ok {
-		data.CurrentPoll = &poll
-		if err := k.IterateThroughCurrentPollAnswers(ctx, func(acc string, ans bool) (stop bool) {
-			data.PollAnswers = append(data.PollAnswers, types.PollAnswer{Acc: acc, Ans: ans})
+	data.PendingChanges = k.GetPendingChanges(ctx)
+	data.ActivePolls = k.GetActivePolls(ctx)
+	data.NextPollId = k.GetNextPollId(ctx)
+	for _, poll := range data.ActivePolls {
+		id := poll.Id
+		k.IteratePollWeights(ctx, id, func(acc string, weight uint64) (stop bool) {
+			data.PollWeights = append(data.PollWeights, types.PollWeight{Acc: acc, Weight: weight, PollId: id})
+			return false
+		})
+		k.IteratePollCommitments(ctx, id, func(acc string, hash []byte) (stop bool) {
+			data.PollCommitments = append(data.PollCommitments, types.PollCommitment{Acc: acc, Hash: hash, PollId: id})
+			return false
+		})
+		if err := k.IteratePollAnswers(ctx, id, func(acc string, ans bool, option uint32) (stop bool) {
+			data.PollAnswers = append(data.PollAnswers, types.PollAnswer{Acc: acc, Ans: ans, Option: option, PollId: id})
 			return false
 		}); err != nil {
 			panic(err)
@@ -67,8 +67,8 @@ func (s Suite) TestCleanGenesis() {
 	s.checkExportImport()
 }
 
-func (s Suite) TestCurrentProposal() {
-	s.k.SetCurrentProposal(s.ctx, types.Proposal{
+func (s Suite) TestActiveProposals() {
+	s.NoError(s.k.Propose(s.ctx, types.MsgPropose{Proposal: types.Proposal{
 		Name: "halving",
 		Type: types.PROPOSAL_TYPE_ACCRUE_PERCENTAGE_TABLE,
 		Args: &types.Proposal_AccruePercentageTable{
@@ -107,12 +107,15 @@ func (s Suite) TestCurrentProposal() {
 		},
 		Author:   app.DefaultGenesisUsers["user1"].String(),
 		EndBlock: 42,
-	})
-	s.k.SetStartBlock(s.ctx)
-	s.k.SetAgreed(s.ctx, types.Government{Members: []string{app.DefaultGenesisUsers["user2"].String()}})
-	s.k.SetAgreed(s.ctx, types.Government{Members: []string{app.DefaultGenesisUsers["user3"].String()}})
-	s.k.SetDisagreed(s.ctx, types.Government{Members: []string{app.DefaultGenesisUsers["user4"].String()}})
-	s.k.SetDisagreed(s.ctx, types.Government{Members: []string{app.DefaultGenesisUsers["user5"].String()}})
+	}}))
+	s.NoError(s.k.Propose(s.ctx, types.MsgPropose{Proposal: types.Proposal{
+		Name:   "min send",
+		Type:   types.PROPOSAL_TYPE_MIN_SEND,
+		Args:   &types.Proposal_MinAmount{MinAmount: &types.MinAmountArgs{MinAmount: 1000}},
+		Author: app.DefaultGenesisUsers["user2"].String(),
+	}}))
+	s.NoError(s.k.Vote(s.ctx, app.DefaultGenesisUsers["user3"], 2, types.VOTE_OPTION_NO))
+	s.Equal(2, len(s.k.GetActiveProposals(s.ctx, 0, 0)))
 	s.checkExportImport()
 }
 
@@ -158,9 +161,10 @@ func (s Suite) TestHistory() {
 		EndTime: &time.Time{},
 	}
 	*proposal.EndTime = time.Date(2021, 8, 3, 11, 20, 10, 666128000, time.UTC)
+	proposal.Id = 1
 
-	s.k.SetCurrentProposal(s.ctx, proposal)
-	s.k.SetStartBlock(s.ctx)
+	s.k.SetActiveProposal(s.ctx, types.ActiveProposal{Proposal: proposal, Started: s.ctx.BlockHeight()})
+	s.k.SetNextProposalId(s.ctx, 2)
 	s.k.EndProposal(s.ctx, proposal, true)
 	s.Equal(1, len(s.k.GetHistory(s.ctx, 100, 1)))
 	s.checkExportImport()
@@ -173,7 +177,9 @@ func (s *Suite) TestGovernment() {
 }
 
 func (s *Suite) TestParams() {
-	s.k.SetParams(s.ctx, types.NewParams(33, 42))
+	s.k.SetParams(s.ctx, types.NewParams(33, 42, util.Percent(60), util.Percent(75), []types.CategoryThreshold{
+		{Category: types.PROPOSAL_CATEGORY_UPGRADE, Threshold: util.Percent(90)},
+	}, util.Percent(20), 12, 2, 48, 1_000000))
 	s.checkExportImport()
 }
 
@@ -186,9 +192,91 @@ func (s *Suite) TestActivePoll_FullData() {
 		Quorum:       &zero,
 		Requirements: &types.Poll_CanValidate{CanValidate: &types.Poll_Unit{}},
 	}))
-	s.NoError(s.k.Answer(s.ctx, app.DefaultGenesisUsers["user1"].String(), true))
-	s.NoError(s.k.Answer(s.ctx, app.DefaultGenesisUsers["user2"].String(), false))
-	s.NoError(s.k.Answer(s.ctx, app.DefaultGenesisUsers["user3"].String(), true))
+	s.NoError(s.k.Answer(s.ctx, 1, app.DefaultGenesisUsers["user1"].String(), true, 0))
+	s.NoError(s.k.Answer(s.ctx, 1, app.DefaultGenesisUsers["user2"].String(), false, 0))
+	s.NoError(s.k.Answer(s.ctx, 1, app.DefaultGenesisUsers["user3"].String(), true, 0))
+	s.checkExportImport()
+}
+
+func (s *Suite) TestActivePoll_StakeWeightedMultiChoice() {
+	s.NoError(s.k.StartPoll(s.ctx, types.Poll{
+		Author:        app.DefaultGenesisUsers["user1"].String(),
+		Question:      "When?",
+		Requirements:  &types.Poll_CanValidate{CanValidate: &types.Poll_Unit{}},
+		StakeWeighted: true,
+		Options:       []string{"today", "tomorrow"},
+	}))
+	s.NoError(s.k.Answer(s.ctx, 1, app.DefaultGenesisUsers["user1"].String(), false, 2))
+	s.NoError(s.k.Answer(s.ctx, 1, app.DefaultGenesisUsers["user2"].String(), false, 1))
+	s.checkExportImport()
+}
+
+func (s *Suite) TestActivePoll_Secret() {
+	var (
+		user1 = app.DefaultGenesisUsers["user1"].String()
+		user2 = app.DefaultGenesisUsers["user2"].String()
+	)
+	s.NoError(s.k.StartPoll(s.ctx, types.Poll{
+		Author:       user1,
+		Question:     "Yes?",
+		Requirements: &types.Poll_CanValidate{CanValidate: &types.Poll_Unit{}},
+		Secret:       true,
+	}))
+	s.NoError(s.k.CommitAnswer(s.ctx, 1, user1, types.PollCommitmentHash(user1, true, 0, "salt1")))
+	s.NoError(s.k.CommitAnswer(s.ctx, 1, user2, types.PollCommitmentHash(user2, false, 0, "salt2")))
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(20 * time.Hour))
+	s.NoError(s.k.RevealAnswer(s.ctx, 1, user1, true, 0, "salt1"))
+	s.checkExportImport()
+}
+
+func (s Suite) TestPendingChanges() {
+	activateAt := s.ctx.BlockTime().Add(72 * time.Hour)
+	proposal := types.Proposal{
+		Name:       "min send",
+		Type:       types.PROPOSAL_TYPE_MIN_SEND,
+		Args:       &types.Proposal_MinAmount{MinAmount: &types.MinAmountArgs{MinAmount: 1000}},
+		Author:     app.DefaultGenesisUsers["user1"].String(),
+		EndTime:    &time.Time{},
+		ActivateAt: &activateAt,
+	}
+	*proposal.EndTime = s.ctx.BlockTime().Add(24 * time.Hour)
+	proposal.Id = 1
+
+	s.k.SetActiveProposal(s.ctx, types.ActiveProposal{Proposal: proposal, Started: s.ctx.BlockHeight()})
+	s.k.SetNextProposalId(s.ctx, 2)
+	s.k.EndProposal(s.ctx, proposal, true)
+	s.Equal(1, len(s.k.GetPendingChanges(s.ctx)))
+	s.checkExportImport()
+}
+
+func (s *Suite) TestActivePolls_Parallel() {
+	var (
+		user1 = app.DefaultGenesisUsers["user1"].String()
+		user2 = app.DefaultGenesisUsers["user2"].String()
+	)
+	s.NoError(s.k.StartPoll(s.ctx, types.Poll{
+		Author:       user1,
+		Question:     "Yes?",
+		Requirements: &types.Poll_CanValidate{CanValidate: &types.Poll_Unit{}},
+	}))
+	s.NoError(s.k.StartPoll(s.ctx, types.Poll{
+		Author:        user2,
+		Question:      "When?",
+		Requirements:  &types.Poll_CanValidate{CanValidate: &types.Poll_Unit{}},
+		StakeWeighted: true,
+		Options:       []string{"today", "tomorrow"},
+		Period:        6,
+	}))
+	s.NoError(s.k.StartPoll(s.ctx, types.Poll{
+		Author:       user1,
+		Question:     "Cancel?",
+		Requirements: &types.Poll_CanValidate{CanValidate: &types.Poll_Unit{}},
+	}))
+	s.NoError(s.k.Answer(s.ctx, 1, user1, true, 0))
+	s.NoError(s.k.Answer(s.ctx, 2, user1, false, 2))
+	s.NoError(s.k.Answer(s.ctx, 2, user2, false, 1))
+	s.NoError(s.k.CancelPoll(s.ctx, 1, user2))
+	s.NoError(s.k.CancelPoll(s.ctx, 3, user1))
 	s.checkExportImport()
 }
 
@@ -219,14 +307,14 @@ func (s *Suite) TestPollHistory() {
 		Quorum:       &zero,
 		Requirements: &types.Poll_CanValidate{CanValidate: &types.Poll_Unit{}},
 	}))
-	s.NoError(s.k.Answer(s.ctx, app.DefaultGenesisUsers["user1"].String(), true))
-	s.NoError(s.k.Answer(s.ctx, app.DefaultGenesisUsers["user2"].String(), false))
-	s.NoError(s.k.Answer(s.ctx, app.DefaultGenesisUsers["user3"].String(), true))
+	s.NoError(s.k.Answer(s.ctx, 1, app.DefaultGenesisUsers["user1"].String(), true, 0))
+	s.NoError(s.k.Answer(s.ctx, 1, app.DefaultGenesisUsers["user2"].String(), false, 0))
+	s.NoError(s.k.Answer(s.ctx, 1, app.DefaultGenesisUsers["user3"].String(), true, 0))
 
 	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(19 * time.Hour)).WithBlockHeight(s.ctx.BlockHeight() + 1)
 	s.nextBlock()
 
-	_, ok := s.k.GetCurrentPoll(s.ctx)
+	_, ok := s.k.GetPoll(s.ctx, 1)
 	s.False(ok)
 
 	s.checkExportImport()
@@ -241,8 +329,15 @@ func (s Suite) checkExportImport() {
 		},
 		map[string]app.Decoder{
 			types.StoreKey: func(bz []byte) (string, error) {
-				if (len(bz) == len(types.KeyHistoryPrefix)+8) && bytes.Equal(types.KeyHistoryPrefix, bz[:len(types.KeyHistoryPrefix)]) {
-					return fmt.Sprintf("%s %d", string(types.KeyHistoryPrefix), binary.BigEndian.Uint64(bz[len(types.KeyHistoryPrefix):])), nil
+				if (len(bz) == len(types.KeyHistoryPrefix)+16) && bytes.Equal(types.KeyHistoryPrefix, bz[:len(types.KeyHistoryPrefix)]) {
+					return fmt.Sprintf("%s %d %d",
+						string(types.KeyHistoryPrefix),
+						binary.BigEndian.Uint64(bz[len(types.KeyHistoryPrefix):]),
+						binary.BigEndian.Uint64(bz[len(types.KeyHistoryPrefix)+8:]),
+					), nil
+				}
+				if (len(bz) == len(types.KeyActivePrefix)+8) && bytes.Equal(types.KeyActivePrefix, bz[:len(types.KeyActivePrefix)]) {
+					return fmt.Sprintf("%s%d", string(types.KeyActivePrefix), binary.BigEndian.Uint64(bz[len(types.KeyActivePrefix):])), nil
 				}
 				if utf8.Valid(bz) {
 					return string(bz), nil
@@ -21,12 +21,24 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgVote:
 			res, err := srv.Vote(sdkCtx, msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgChangeVote:
+			res, err := srv.ChangeVote(sdkCtx, msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		case *types.MsgStartPoll:
 			res, err := srv.StartPoll(sdkCtx, msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 		case *types.MsgAnswerPoll:
 			res, err := srv.AnswerPoll(sdkCtx, msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgCommitAnswer:
+			res, err := srv.CommitAnswer(sdkCtx, msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgRevealAnswer:
+			res, err := srv.RevealAnswer(sdkCtx, msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgCancelPoll:
+			res, err := srv.CancelPoll(sdkCtx, msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
 		}
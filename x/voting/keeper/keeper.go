@@ -12,13 +12,17 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/cosmos/cosmos-sdk/codec"
-	"github.com/cosmos/cosmos-sdk/store/cachekv"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	params "github.com/cosmos/cosmos-sdk/x/params/types"
 	upgrade "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
 	"github.com/arterynetwork/artr/util"
+	bank "github.com/arterynetwork/artr/x/bank/types"
+	delegating "github.com/arterynetwork/artr/x/delegating/types"
+	noding "github.com/arterynetwork/artr/x/noding/types"
+	profile "github.com/arterynetwork/artr/x/profile/types"
+	referral "github.com/arterynetwork/artr/x/referral/types"
 	"github.com/arterynetwork/artr/x/voting/types"
 )
 
@@ -35,6 +39,7 @@ type Keeper struct {
 	profileKeeper    types.ProfileKeeper
 	earningKeeper    types.EarningKeeper
 	bankKeeper       types.BankKeeper
+	subspaces        map[string]params.Subspace
 }
 
 // NewKeeper creates a voting keeper
@@ -48,6 +53,7 @@ func NewKeeper(
 	profileKeeper types.ProfileKeeper,
 	earningKeeper types.EarningKeeper,
 	bankKeeper types.BankKeeper,
+	subspaces map[string]params.Subspace,
 ) Keeper {
 	keeper := Keeper{
 		storeKey:         key,
@@ -61,6 +67,7 @@ func NewKeeper(
 		profileKeeper:    profileKeeper,
 		earningKeeper:    earningKeeper,
 		bankKeeper:       bankKeeper,
+		subspaces:        subspaces,
 	}
 	return keeper
 }
@@ -70,110 +77,133 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
-func (k Keeper) GetCurrentProposal(ctx sdk.Context) *types.Proposal {
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.KeyCurrentVote)
-
+// GetActiveProposal returns a proposal being voted at the moment by its ID.
+func (k Keeper) GetActiveProposal(ctx sdk.Context, id uint64) (ap types.ActiveProposal, ok bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyActivePrefix)
+	bz := store.Get(proposalIdKey(id))
 	if bz == nil {
-		return nil
-	}
-
-	var proposal types.Proposal
-	err := proto.Unmarshal(bz, &proposal)
-
-	if err != nil {
-		panic(err)
+		return types.ActiveProposal{}, false
 	}
 
-	return &proposal
+	k.cdc.MustUnmarshalBinaryBare(bz, &ap)
+	return ap, true
 }
 
-func (k Keeper) SetCurrentProposal(ctx sdk.Context, proposal types.Proposal) {
-	store := ctx.KVStore(k.storeKey)
-	bz, err := proto.Marshal(&proposal)
-	if err != nil {
-		panic(err)
-	}
-	store.Set(types.KeyCurrentVote, bz)
+func (k Keeper) SetActiveProposal(ctx sdk.Context, ap types.ActiveProposal) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyActivePrefix)
+	store.Set(proposalIdKey(ap.Proposal.Id), k.cdc.MustMarshalBinaryBare(&ap))
 }
 
-func (k Keeper) GetAgreed(ctx sdk.Context) (gov types.Government) {
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.KeyAgreedMembers)
+func (k Keeper) deleteActiveProposal(ctx sdk.Context, id uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyActivePrefix)
+	store.Delete(proposalIdKey(id))
+}
 
-	if bz == nil {
-		return types.Government{}
-	}
+// IterateActiveProposals calls the callback for every proposal being voted at the moment in the ID order.
+func (k Keeper) IterateActiveProposals(ctx sdk.Context, callback func(ap types.ActiveProposal) (stop bool)) {
+	it := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyActivePrefix)
+	defer it.Close()
 
-	if err := proto.Unmarshal(bz, &gov); err != nil {
-		panic(err)
+	for ; it.Valid(); it.Next() {
+		var ap types.ActiveProposal
+		k.cdc.MustUnmarshalBinaryBare(it.Value(), &ap)
+		if callback(ap) {
+			return
+		}
 	}
-	return gov
 }
 
-func (k Keeper) SetAgreed(ctx sdk.Context, agreed types.Government) {
+// GetActiveProposals returns a page of the proposals being voted at the moment. Zero limit means all of them.
+func (k Keeper) GetActiveProposals(ctx sdk.Context, limit int32, page int32) []types.ActiveProposal {
 	store := ctx.KVStore(k.storeKey)
-	bz, err := proto.Marshal(&agreed)
-	if err != nil {
-		panic(err)
+	var (
+		it  sdk.Iterator
+		res []types.ActiveProposal
+	)
+	if limit > 0 {
+		it = sdk.KVStorePrefixIteratorPaginated(store, types.KeyActivePrefix, uint(page), uint(limit))
+		res = make([]types.ActiveProposal, 0, limit)
+	} else {
+		it = sdk.KVStorePrefixIterator(store, types.KeyActivePrefix)
 	}
-	store.Set(types.KeyAgreedMembers, bz)
-}
+	defer it.Close()
 
-func (k Keeper) GetDisagreed(ctx sdk.Context) (gov types.Government) {
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.KeyDisagreedMembers)
+	for ; it.Valid(); it.Next() {
+		var ap types.ActiveProposal
+		k.cdc.MustUnmarshalBinaryBare(it.Value(), &ap)
+		res = append(res, ap)
+	}
+	return res
+}
 
+// GetNextProposalId returns an ID the next proposal is going to get.
+func (k Keeper) GetNextProposalId(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyNextProposalId)
 	if bz == nil {
-		return types.Government{}
+		return 1
 	}
+	return binary.BigEndian.Uint64(bz)
+}
 
-	if err := proto.Unmarshal(bz, &gov); err != nil {
-		panic(err)
-	}
-	return gov
+func (k Keeper) SetNextProposalId(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.KeyNextProposalId, proposalIdKey(id))
 }
 
-func (k Keeper) SetDisagreed(ctx sdk.Context, disagreed types.Government) {
-	store := ctx.KVStore(k.storeKey)
-	bz, err := proto.Marshal(&disagreed)
-	if err != nil {
-		panic(err)
-	}
-	store.Set(types.KeyDisagreedMembers, bz)
+func (k Keeper) allocateProposalId(ctx sdk.Context) uint64 {
+	id := k.GetNextProposalId(ctx)
+	k.SetNextProposalId(ctx, id+1)
+	return id
 }
 
-func (k Keeper) Validate(gov types.Government,
-	aGov types.Government,
-	dGov types.Government,
-) (complete bool, agreed bool) {
+func proposalIdKey(id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return bz
+}
 
-	if len(gov.Members) == (len(aGov.Members) + len(dGov.Members)) {
-		complete = true
-		agreed = len(aGov.Members)*3 >= len(gov.Members)*2
-	}
+// Validate tells whether a proposal's outcome is already settled, i.e. no remaining votes can change it.
+func (k Keeper) Validate(ctx sdk.Context, ap types.ActiveProposal, gov types.Government) (complete bool, agreed bool) {
+	return k.GetParams(ctx).Decide(ap.Proposal.Categories(), len(gov.Members), ap.Tally(), false)
+}
 
-	return complete, agreed
+func (k Keeper) SaveProposalToHistory(
+	ctx sdk.Context,
+	ap types.ActiveProposal,
+	status types.ExecutionStatus,
+	execErr string,
+	rejectedByVeto bool,
+) {
+	k.AddProposalHistoryRecord(ctx, types.ProposalHistoryRecord{
+		Proposal:       ap.Proposal,
+		Government:     k.GetGovernment(ctx).Members,
+		Agreed:         ap.Agreed,
+		Disagreed:      ap.Disagreed,
+		Abstained:      ap.Abstained,
+		Vetoed:         ap.Vetoed,
+		Started:        ap.Started,
+		Finished:       ctx.BlockHeight(),
+		Status:         status,
+		Error:          execErr,
+		RejectedByVeto: rejectedByVeto,
+		Deposit:        ap.Deposit,
+	})
 }
 
-func (k Keeper) SaveProposalToHistory(ctx sdk.Context, store sdk.KVStore) {
-	history := types.ProposalHistoryRecord{
-		Proposal:   *k.GetCurrentProposal(ctx),
-		Government: k.GetGovernment(ctx).Members,
-		Agreed:     k.GetAgreed(ctx).Members,
-		Disagreed:  k.GetDisagreed(ctx).Members,
-		Started:    k.GetStartBlock(ctx),
-		Finished:   ctx.BlockHeight(),
+// settleDeposit returns a proposal deposit to its author, or burns it if the proposal is rejected by veto.
+func (k Keeper) settleDeposit(ctx sdk.Context, ap types.ActiveProposal, burn bool) {
+	if ap.Deposit <= 0 {
+		return
 	}
-
-	historyBz, err := proto.Marshal(&history)
-	if err != nil {
-		panic(err)
+	coins := util.Uartrs(ap.Deposit)
+	if burn {
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+			panic(errors.Wrapf(err, "cannot burn proposal #%d deposit", ap.Proposal.Id))
+		}
+	} else {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, ap.Proposal.GetAuthor(), coins); err != nil {
+			panic(errors.Wrapf(err, "cannot refund proposal #%d deposit", ap.Proposal.Id))
+		}
 	}
-	height := make([]byte, 8)
-	binary.BigEndian.PutUint64(height, uint64(ctx.BlockHeight()))
-	key := append(types.KeyHistoryPrefix, height...)
-	store.Set(key, historyBz)
 }
 
 func (k Keeper) AddProposalHistoryRecord(ctx sdk.Context, record types.ProposalHistoryRecord) {
@@ -182,230 +212,473 @@ func (k Keeper) AddProposalHistoryRecord(ctx sdk.Context, record types.ProposalH
 	if err != nil {
 		panic(err)
 	}
-	height := make([]byte, 8)
-	binary.BigEndian.PutUint64(height, uint64(record.Finished))
-	key := append(types.KeyHistoryPrefix, height...)
-	store.Set(key, historyBz)
+	store.Set(historyKey(record.Finished, record.Proposal.Id), historyBz)
 }
 
-func (k Keeper) SetStartBlock(ctx sdk.Context) {
-	store := ctx.KVStore(k.storeKey)
-	bz := make([]byte, 8)
-	binary.BigEndian.PutUint64(bz, (uint64(ctx.BlockHeight())))
-	store.Set(types.KeyStartBlock, bz)
+func (k Keeper) getProposalHistoryRecord(ctx sdk.Context, finished int64, id uint64) (record types.ProposalHistoryRecord, ok bool) {
+	bz := ctx.KVStore(k.storeKey).Get(historyKey(finished, id))
+	if bz == nil {
+		return record, false
+	}
+	if err := proto.Unmarshal(bz, &record); err != nil {
+		panic(err)
+	}
+	return record, true
 }
 
-func (k Keeper) GetStartBlock(ctx sdk.Context) int64 {
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.KeyStartBlock)
-	return int64(binary.BigEndian.Uint64(bz))
+func historyKey(finished int64, id uint64) []byte {
+	key := make([]byte, len(types.KeyHistoryPrefix)+16)
+	copy(key, types.KeyHistoryPrefix)
+	binary.BigEndian.PutUint64(key[len(types.KeyHistoryPrefix):], uint64(finished))
+	binary.BigEndian.PutUint64(key[len(types.KeyHistoryPrefix)+8:], id)
+	return key
 }
 
 func (k Keeper) EndProposal(ctx sdk.Context, proposal types.Proposal, agreed bool) {
 	k.Logger(ctx).Debug("EndProposal", "proposal", proposal, "agreed", agreed)
-	// Delete scheduled completion
-	k.scheduleKeeper.DeleteAll(ctx, *proposal.EndTime, types.VoteHookName)
-
-	store := ctx.KVStore(k.storeKey)
+	ap, ok := k.GetActiveProposal(ctx, proposal.Id)
+	if !ok {
+		panic(errors.Wrapf(types.ErrNoActiveProposals, "proposal #%d", proposal.Id))
+	}
 
-	// Save proposal data to history
-	k.SaveProposalToHistory(ctx, store)
+	// Delete scheduled completion
+	k.scheduleKeeper.Delete(ctx, *proposal.EndTime, types.VoteHookName, proposalIdKey(proposal.Id))
 
 	// Delete all proposal info
-	store.Delete(types.KeyCurrentVote)
-	store.Delete(types.KeyAgreedMembers)
-	store.Delete(types.KeyDisagreedMembers)
-	store.Delete(types.KeyStartBlock)
+	k.deleteActiveProposal(ctx, proposal.Id)
 
+	rejectedByVeto := !agreed && k.GetParams(ctx).IsVetoed(ap.Tally())
+	k.settleDeposit(ctx, ap, rejectedByVeto)
 	util.EmitEvent(ctx,
 		&types.EventVotingFinished{
-			Name:   proposal.Name,
-			Agreed: agreed,
+			Name:           proposal.Name,
+			Agreed:         agreed,
+			Id:             proposal.Id,
+			RejectedByVeto: rejectedByVeto,
 		},
 	)
 
+	var (
+		status  = types.EXECUTION_STATUS_REJECTED
+		execErr string
+	)
 	if agreed {
-		var err error
-		switch proposal.Type {
-		case types.PROPOSAL_TYPE_ENTER_PRICE:
-			p := k.profileKeeper.GetParams(ctx)
-			p.SubscriptionPrice = proposal.GetPrice().Price
-			k.profileKeeper.SetParams(ctx, p)
-		case types.PROPOSAL_TYPE_DELEGATION_AWARD:
-			err = errors.New("parameter is deprecated")
-		case types.PROPOSAL_TYPE_DELEGATION_NETWORK_AWARD:
-			err = errors.New("parameter is deprecated")
-		case types.PROPOSAL_TYPE_PRODUCT_NETWORK_AWARD:
-			err = errors.New("parameter is deprecated")
-		case types.PROPOSAL_TYPE_GOVERNMENT_ADD:
-			k.AddGovernor(ctx, proposal.GetAddress().GetAddress())
-		case types.PROPOSAL_TYPE_GOVERNMENT_REMOVE:
-			k.RemoveGovernor(ctx, proposal.GetAddress().GetAddress())
-		case types.PROPOSAL_TYPE_PRODUCT_VPN_BASE_PRICE:
-			p := k.profileKeeper.GetParams(ctx)
-			p.VpnGbPrice = proposal.GetPrice().Price
-			k.profileKeeper.SetParams(ctx, p)
-		case types.PROPOSAL_TYPE_PRODUCT_STORAGE_BASE_PRICE:
-			p := k.profileKeeper.GetParams(ctx)
-			p.StorageGbPrice = proposal.GetPrice().Price
-			k.profileKeeper.SetParams(ctx, p)
-		case types.PROPOSAL_TYPE_FREE_CREATOR_ADD:
-			k.profileKeeper.AddFreeCreator(ctx, proposal.GetAddress().GetAddress())
-		case types.PROPOSAL_TYPE_FREE_CREATOR_REMOVE:
-			k.profileKeeper.RemoveFreeCreator(ctx, proposal.GetAddress().GetAddress())
-		case types.PROPOSAL_TYPE_SOFTWARE_UPGRADE:
-			p := proposal.GetSoftwareUpgrade()
-			plan := upgrade.Plan{
-				Name: p.Name,
-				Info: p.Info,
-			}
-			if p.Time != nil {
-				plan.Time = *p.Time
-			}
-			err = k.upgradeKeeper.ScheduleUpgrade(ctx, plan)
-		case types.PROPOSAL_TYPE_CANCEL_SOFTWARE_UPGRADE:
-			k.upgradeKeeper.ClearUpgradePlan(ctx)
-		case types.PROPOSAL_TYPE_STAFF_VALIDATOR_ADD:
-			err = k.nodingKeeper.AddToStaff(ctx, proposal.GetAddress().GetAddress())
-		case types.PROPOSAL_TYPE_STAFF_VALIDATOR_REMOVE:
-			err = k.nodingKeeper.RemoveFromStaff(ctx, proposal.GetAddress().GetAddress())
-		case types.PROPOSAL_TYPE_EARNING_SIGNER_ADD:
-			k.earningKeeper.AddSigner(ctx, proposal.GetAddress().GetAddress())
-		case types.PROPOSAL_TYPE_EARNING_SIGNER_REMOVE:
-			k.earningKeeper.RemoveSigner(ctx, proposal.GetAddress().GetAddress())
-		case types.PROPOSAL_TYPE_TOKEN_RATE_SIGNER_ADD:
-			k.profileKeeper.AddTokenRateSigner(ctx, proposal.GetAddress().GetAddress())
-		case types.PROPOSAL_TYPE_TOKEN_RATE_SIGNER_REMOVE:
-			k.profileKeeper.RemoveTokenRateSigner(ctx, proposal.GetAddress().GetAddress())
-		case types.PROPOSAL_TYPE_VPN_SIGNER_ADD:
-			k.profileKeeper.AddVpnCurrentSigner(ctx, proposal.GetAddress().GetAddress())
-		case types.PROPOSAL_TYPE_VPN_SIGNER_REMOVE:
-			k.profileKeeper.RemoveVpnCurrentSigner(ctx, proposal.GetAddress().GetAddress())
-		case types.PROPOSAL_TYPE_STORAGE_SIGNER_ADD:
-			k.profileKeeper.AddStorageCurrentSigner(ctx, proposal.GetAddress().GetAddress())
-		case types.PROPOSAL_TYPE_STORAGE_SIGNER_REMOVE:
-			k.profileKeeper.RemoveStorageCurrentSigner(ctx, proposal.GetAddress().GetAddress())
-		case types.PROPOSAL_TYPE_TRANSITION_PRICE:
-			p := k.referralKeeper.GetParams(ctx)
-			p.TransitionPrice = uint64(proposal.GetPrice().Price)
-			k.referralKeeper.SetParams(ctx, p)
-		case types.PROPOSAL_TYPE_MIN_SEND:
-			p := k.bankKeeper.GetParams(ctx)
-			p.MinSend = proposal.GetMinAmount().MinAmount
+		if proposal.ActivateAt != nil && proposal.ActivateAt.After(ctx.BlockTime()) {
+			k.setPendingChange(ctx, types.PendingChange{Proposal: proposal, Finished: ctx.BlockHeight()})
+			k.scheduleKeeper.ScheduleTask(ctx, *proposal.ActivateAt, types.ActivateHookName, proposalIdKey(proposal.Id))
+			status = types.EXECUTION_STATUS_SCHEDULED
+		} else {
+			status, execErr = k.execute(ctx, proposal)
+		}
+	}
+
+	// Save proposal data to history
+	k.SaveProposalToHistory(ctx, ap, status, execErr, rejectedByVeto)
+}
+
+// execute applies an agreed proposal. If it fails, the state is left intact and the error is reported by an event.
+func (k Keeper) execute(ctx sdk.Context, proposal types.Proposal) (status types.ExecutionStatus, execErr string) {
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.applyChange(cacheCtx, proposal); err != nil {
+		k.Logger(ctx).Error("could not apply voting result due to error",
+			"name", proposal.Name,
+			"error", err,
+		)
+		execErr = err.Error()
+		util.EmitEvent(ctx,
+			&types.EventProposalExecutionFailed{
+				Id:    proposal.Id,
+				Name:  proposal.Name,
+				Error: execErr,
+			},
+		)
+		return types.EXECUTION_STATUS_FAILED, execErr
+	}
+	write()
+	return types.EXECUTION_STATUS_SUCCEEDED, ""
+}
+
+func (k Keeper) GetPendingChange(ctx sdk.Context, id uint64) (pc types.PendingChange, ok bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPendingPrefix)
+	bz := store.Get(proposalIdKey(id))
+	if bz == nil {
+		return pc, false
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &pc)
+	return pc, true
+}
+
+func (k Keeper) setPendingChange(ctx sdk.Context, pc types.PendingChange) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPendingPrefix)
+	store.Set(proposalIdKey(pc.Proposal.Id), k.cdc.MustMarshalBinaryBare(&pc))
+}
+
+func (k Keeper) deletePendingChange(ctx sdk.Context, id uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPendingPrefix)
+	store.Delete(proposalIdKey(id))
+}
+
+func (k Keeper) IteratePendingChanges(ctx sdk.Context, callback func(pc types.PendingChange) (stop bool)) {
+	it := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPendingPrefix)
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		var pc types.PendingChange
+		k.cdc.MustUnmarshalBinaryBare(it.Value(), &pc)
+		if callback(pc) {
+			break
+		}
+	}
+}
+
+// GetPendingChanges returns all agreed proposals waiting for their activation time, ordered by ID.
+func (k Keeper) GetPendingChanges(ctx sdk.Context) []types.PendingChange {
+	var result []types.PendingChange
+	k.IteratePendingChanges(ctx, func(pc types.PendingChange) (stop bool) {
+		result = append(result, pc)
+		return false
+	})
+	return result
+}
+
+// ActivateHandler applies a pending change when its activation time comes and updates its history record.
+func (k Keeper) ActivateHandler(ctx sdk.Context, data []byte, _ time.Time) {
+	if len(data) != 8 {
+		k.Logger(ctx).Error("ActivateHandler: unexpected payload", "data", data)
+		return
+	}
+	pc, ok := k.GetPendingChange(ctx, binary.BigEndian.Uint64(data))
+	if !ok {
+		return
+	}
+	k.deletePendingChange(ctx, pc.Proposal.Id)
+
+	status, execErr := k.execute(ctx, pc.Proposal)
+	if status == types.EXECUTION_STATUS_SUCCEEDED {
+		util.EmitEvent(ctx,
+			&types.EventProposalActivated{
+				Id:   pc.Proposal.Id,
+				Name: pc.Proposal.Name,
+			},
+		)
+	}
+
+	record, ok := k.getProposalHistoryRecord(ctx, pc.Finished, pc.Proposal.Id)
+	if !ok {
+		k.Logger(ctx).Error("ActivateHandler: history record not found", "id", pc.Proposal.Id, "finished", pc.Finished)
+		return
+	}
+	record.Status = status
+	record.Error = execErr
+	k.AddProposalHistoryRecord(ctx, record)
+}
+
+// checkApplicable applies a proposal to a throwaway copy of the state to make sure it's applicable at the moment.
+func (k Keeper) checkApplicable(ctx sdk.Context, proposal types.Proposal) error {
+	cacheCtx, _ := ctx.CacheContext()
+	if err := k.applyChange(cacheCtx.WithEventManager(sdk.NewEventManager()), proposal); err != nil {
+		return errors.Wrap(types.ErrProposalCannotBeApplied, err.Error())
+	}
+	return nil
+}
+
+func (k Keeper) applyProposal(ctx sdk.Context, proposal types.Proposal) (err error) {
+	switch proposal.Type {
+	case types.PROPOSAL_TYPE_ENTER_PRICE:
+		p := k.profileKeeper.GetParams(ctx)
+		p.SubscriptionPrice = proposal.GetPrice().Price
+		k.profileKeeper.SetParams(ctx, p)
+	case types.PROPOSAL_TYPE_DELEGATION_AWARD:
+		err = errors.New("parameter is deprecated")
+	case types.PROPOSAL_TYPE_DELEGATION_NETWORK_AWARD:
+		err = errors.New("parameter is deprecated")
+	case types.PROPOSAL_TYPE_PRODUCT_NETWORK_AWARD:
+		err = errors.New("parameter is deprecated")
+	case types.PROPOSAL_TYPE_GOVERNMENT_ADD:
+		addr := proposal.GetAddress().GetAddress()
+		if k.GetGovernment(ctx).Contains(addr) {
+			return types.ErrProposalGovernorExists
+		}
+		k.AddGovernor(ctx, addr)
+	case types.PROPOSAL_TYPE_GOVERNMENT_REMOVE:
+		addr := proposal.GetAddress().GetAddress()
+		if gov := k.GetGovernment(ctx); !gov.Contains(addr) {
+			return types.ErrProposalGovernorNotExists
+		} else if len(gov.Members) == 1 {
+			return types.ErrProposalGovernorLast
+		}
+		k.RemoveGovernor(ctx, addr)
+	case types.PROPOSAL_TYPE_PRODUCT_VPN_BASE_PRICE:
+		p := k.profileKeeper.GetParams(ctx)
+		p.VpnGbPrice = proposal.GetPrice().Price
+		k.profileKeeper.SetParams(ctx, p)
+	case types.PROPOSAL_TYPE_PRODUCT_STORAGE_BASE_PRICE:
+		p := k.profileKeeper.GetParams(ctx)
+		p.StorageGbPrice = proposal.GetPrice().Price
+		k.profileKeeper.SetParams(ctx, p)
+	case types.PROPOSAL_TYPE_FREE_CREATOR_ADD:
+		k.profileKeeper.AddFreeCreator(ctx, proposal.GetAddress().GetAddress())
+	case types.PROPOSAL_TYPE_FREE_CREATOR_REMOVE:
+		k.profileKeeper.RemoveFreeCreator(ctx, proposal.GetAddress().GetAddress())
+	case types.PROPOSAL_TYPE_SOFTWARE_UPGRADE:
+		p := proposal.GetSoftwareUpgrade()
+		plan := upgrade.Plan{
+			Name: p.Name,
+			Info: p.Info,
+		}
+		if p.Time != nil {
+			plan.Time = *p.Time
+		}
+		err = k.upgradeKeeper.ScheduleUpgrade(ctx, plan)
+	case types.PROPOSAL_TYPE_CANCEL_SOFTWARE_UPGRADE:
+		k.upgradeKeeper.ClearUpgradePlan(ctx)
+	case types.PROPOSAL_TYPE_STAFF_VALIDATOR_ADD:
+		if args := proposal.GetStaffValidator(); args != nil {
+			err = k.nodingKeeper.AddToStaff(ctx, args.GetAddress(), proposal.Name, proposal.Author, args.Days)
+		} else {
+			err = k.nodingKeeper.AddToStaff(ctx, proposal.GetAddress().GetAddress(), proposal.Name, proposal.Author, 0)
+		}
+	case types.PROPOSAL_TYPE_STAFF_VALIDATOR_REMOVE:
+		err = k.nodingKeeper.RemoveFromStaff(ctx, proposal.GetAddress().GetAddress())
+	case types.PROPOSAL_TYPE_EARNING_SIGNER_ADD:
+		k.earningKeeper.AddSigner(ctx, proposal.GetAddress().GetAddress())
+	case types.PROPOSAL_TYPE_EARNING_SIGNER_REMOVE:
+		k.earningKeeper.RemoveSigner(ctx, proposal.GetAddress().GetAddress())
+	case types.PROPOSAL_TYPE_TOKEN_RATE_SIGNER_ADD:
+		k.profileKeeper.AddTokenRateSigner(ctx, proposal.GetAddress().GetAddress())
+	case types.PROPOSAL_TYPE_TOKEN_RATE_SIGNER_REMOVE:
+		k.profileKeeper.RemoveTokenRateSigner(ctx, proposal.GetAddress().GetAddress())
+	case types.PROPOSAL_TYPE_VPN_SIGNER_ADD:
+		k.profileKeeper.AddVpnCurrentSigner(ctx, proposal.GetAddress().GetAddress())
+	case types.PROPOSAL_TYPE_VPN_SIGNER_REMOVE:
+		k.profileKeeper.RemoveVpnCurrentSigner(ctx, proposal.GetAddress().GetAddress())
+	case types.PROPOSAL_TYPE_STORAGE_SIGNER_ADD:
+		k.profileKeeper.AddStorageCurrentSigner(ctx, proposal.GetAddress().GetAddress())
+	case types.PROPOSAL_TYPE_STORAGE_SIGNER_REMOVE:
+		k.profileKeeper.RemoveStorageCurrentSigner(ctx, proposal.GetAddress().GetAddress())
+	case types.PROPOSAL_TYPE_TRANSITION_PRICE:
+		p := k.referralKeeper.GetParams(ctx)
+		p.TransitionPrice = uint64(proposal.GetPrice().Price)
+		k.referralKeeper.SetParams(ctx, p)
+	case types.PROPOSAL_TYPE_MIN_SEND:
+		p := k.bankKeeper.GetParams(ctx)
+		p.MinSend = proposal.GetMinAmount().MinAmount
+		k.bankKeeper.SetParams(ctx, p)
+	case types.PROPOSAL_TYPE_MIN_DELEGATE:
+		p := k.delegatingKeeper.GetParams(ctx)
+		p.MinDelegate = proposal.GetMinAmount().MinAmount
+		k.delegatingKeeper.SetParams(ctx, p)
+	case types.PROPOSAL_TYPE_MAX_VALIDATORS:
+		p := k.nodingKeeper.GetParams(ctx)
+		p.MaxValidators = proposal.GetCount().Count
+		k.nodingKeeper.SetParams(ctx, p)
+	case types.PROPOSAL_TYPE_GENERAL_AMNESTY:
+		k.nodingKeeper.GeneralAmnesty(ctx)
+	case types.PROPOSAL_TYPE_LUCKY_VALIDATORS:
+		p := k.nodingKeeper.GetParams(ctx)
+		p.LotteryValidators = proposal.GetCount().Count
+		k.nodingKeeper.SetParams(ctx, p)
+	case types.PROPOSAL_TYPE_VALIDATOR_MINIMAL_STATUS:
+		err = errors.New("parameter is deprecated")
+	case types.PROPOSAL_TYPE_VALIDATOR_MINIMAL_CRITERIA:
+		p := k.nodingKeeper.GetParams(ctx)
+		p.MinCriteria = *proposal.GetMinCriteria().MinCriteria
+		k.nodingKeeper.SetParams(ctx, p)
+	case types.PROPOSAL_TYPE_JAIL_AFTER:
+		p := k.nodingKeeper.GetParams(ctx)
+		p.JailAfter = proposal.GetCount().Count
+		k.nodingKeeper.SetParams(ctx, p)
+	case types.PROPOSAL_TYPE_REVOKE_PERIOD:
+		err = errors.New("parameter is deprecated")
+	case types.PROPOSAL_TYPE_DUST_DELEGATION:
+		p := k.bankKeeper.GetParams(ctx)
+		p.DustDelegation = proposal.GetMinAmount().MinAmount
+		k.bankKeeper.SetParams(ctx, p)
+	case types.PROPOSAL_TYPE_VOTING_POWER:
+		p := k.nodingKeeper.GetParams(ctx)
+		p.VotingPower = *proposal.GetVotingPower()
+		k.nodingKeeper.SetParams(ctx, p)
+	case types.PROPOSAL_TYPE_VALIDATOR_BONUS:
+		err = errors.New("parameter is deprecated")
+	case types.PROPOSAL_TYPE_SUBSCRIPTION_BONUS:
+		err = errors.New("parameter is deprecated")
+	case types.PROPOSAL_TYPE_VPN_BONUS:
+		err = errors.New("parameter is deprecated")
+	case types.PROPOSAL_TYPE_STORAGE_BONUS:
+		err = errors.New("parameter is deprecated")
+	case types.PROPOSAL_TYPE_VALIDATOR:
+		err = errors.New("parameter is deprecated")
+	case types.PROPOSAL_TYPE_TRANSACTION_FEE:
+		p := k.bankKeeper.GetParams(ctx)
+		p.TransactionFee = proposal.GetPortion().Fraction
+		if err = p.Validate(); err == nil {
 			k.bankKeeper.SetParams(ctx, p)
-		case types.PROPOSAL_TYPE_MIN_DELEGATE:
-			p := k.delegatingKeeper.GetParams(ctx)
-			p.MinDelegate = proposal.GetMinAmount().MinAmount
-			k.delegatingKeeper.SetParams(ctx, p)
-		case types.PROPOSAL_TYPE_MAX_VALIDATORS:
-			p := k.nodingKeeper.GetParams(ctx)
-			p.MaxValidators = proposal.GetCount().Count
-			k.nodingKeeper.SetParams(ctx, p)
-		case types.PROPOSAL_TYPE_GENERAL_AMNESTY:
-			k.nodingKeeper.GeneralAmnesty(ctx)
-		case types.PROPOSAL_TYPE_LUCKY_VALIDATORS:
-			p := k.nodingKeeper.GetParams(ctx)
-			p.LotteryValidators = proposal.GetCount().Count
-			k.nodingKeeper.SetParams(ctx, p)
-		case types.PROPOSAL_TYPE_VALIDATOR_MINIMAL_STATUS:
-			err = errors.New("parameter is deprecated")
-		case types.PROPOSAL_TYPE_VALIDATOR_MINIMAL_CRITERIA:
-			p := k.nodingKeeper.GetParams(ctx)
-			p.MinCriteria = *proposal.GetMinCriteria().MinCriteria
-			k.nodingKeeper.SetParams(ctx, p)
-		case types.PROPOSAL_TYPE_JAIL_AFTER:
-			p := k.nodingKeeper.GetParams(ctx)
-			p.JailAfter = proposal.GetCount().Count
-			k.nodingKeeper.SetParams(ctx, p)
-		case types.PROPOSAL_TYPE_REVOKE_PERIOD:
-			err = errors.New("parameter is deprecated")
-		case types.PROPOSAL_TYPE_DUST_DELEGATION:
-			p := k.bankKeeper.GetParams(ctx)
-			p.DustDelegation = proposal.GetMinAmount().MinAmount
+		}
+	case types.PROPOSAL_TYPE_BURN_ON_REVOKE:
+		err = errors.New("parameter is deprecated")
+	case types.PROPOSAL_TYPE_MAX_TRANSACTION_FEE:
+		p := k.bankKeeper.GetParams(ctx)
+		p.MaxTransactionFee = proposal.GetMinAmount().MinAmount
+		if err = p.Validate(); err == nil {
 			k.bankKeeper.SetParams(ctx, p)
-		case types.PROPOSAL_TYPE_VOTING_POWER:
-			p := k.nodingKeeper.GetParams(ctx)
-			p.VotingPower = *proposal.GetVotingPower()
-			k.nodingKeeper.SetParams(ctx, p)
-		case types.PROPOSAL_TYPE_VALIDATOR_BONUS:
-			err = errors.New("parameter is deprecated")
-		case types.PROPOSAL_TYPE_SUBSCRIPTION_BONUS:
-			err = errors.New("parameter is deprecated")
-		case types.PROPOSAL_TYPE_VPN_BONUS:
-			err = errors.New("parameter is deprecated")
-		case types.PROPOSAL_TYPE_STORAGE_BONUS:
-			err = errors.New("parameter is deprecated")
-		case types.PROPOSAL_TYPE_VALIDATOR:
-			err = errors.New("parameter is deprecated")
-		case types.PROPOSAL_TYPE_TRANSACTION_FEE:
-			p := k.bankKeeper.GetParams(ctx)
-			p.TransactionFee = proposal.GetPortion().Fraction
-			if err = p.Validate(); err == nil {
-				k.bankKeeper.SetParams(ctx, p)
-			}
-		case types.PROPOSAL_TYPE_BURN_ON_REVOKE:
-			err = errors.New("parameter is deprecated")
-		case types.PROPOSAL_TYPE_MAX_TRANSACTION_FEE:
-			p := k.bankKeeper.GetParams(ctx)
-			p.MaxTransactionFee = proposal.GetMinAmount().MinAmount
-			if err = p.Validate(); err == nil {
-				k.bankKeeper.SetParams(ctx, p)
-			}
-		case types.PROPOSAL_TYPE_TRANSACTION_FEE_SPLIT_RATIOS:
-			p := k.bankKeeper.GetParams(ctx)
-			p.TransactionFeeSplitRatios.ForProposer = proposal.GetPortions().Fractions[0]
-			p.TransactionFeeSplitRatios.ForCompany = proposal.GetPortions().Fractions[1]
-			if err = p.Validate(); err == nil {
-				k.bankKeeper.SetParams(ctx, p)
-			}
-		case types.PROPOSAL_TYPE_ACCRUE_PERCENTAGE_RANGES:
-			err = errors.New("parameter is deprecated")
-		case types.PROPOSAL_TYPE_ACCRUE_PERCENTAGE_TABLE:
-			p := k.delegatingKeeper.GetParams(ctx)
-			p.AccruePercentageTable = proposal.GetAccruePercentageTable().AccruePercentageTable
-			if err = p.Validate(); err == nil {
-				k.delegatingKeeper.SetParams(ctx, p)
-			}
-		case types.PROPOSAL_TYPE_BLOCKED_SENDER_ADD:
-			k.bankKeeper.AddBlockedSender(ctx, proposal.GetAddress().GetAddress())
-		case types.PROPOSAL_TYPE_BLOCKED_SENDER_REMOVE:
-			k.bankKeeper.RemoveBlockedSender(ctx, proposal.GetAddress().GetAddress())
-		case types.PROPOSAL_TYPE_REVOKE:
-			p := k.delegatingKeeper.GetParams(ctx)
-			p.Revoke = *proposal.GetRevoke().Revoke
-			k.delegatingKeeper.SetParams(ctx, p)
-		case types.PROPOSAL_TYPE_EXPRESS_REVOKE:
-			p := k.delegatingKeeper.GetParams(ctx)
-			p.ExpressRevoke = *proposal.GetRevoke().Revoke
+		}
+	case types.PROPOSAL_TYPE_TRANSACTION_FEE_SPLIT_RATIOS:
+		p := k.bankKeeper.GetParams(ctx)
+		p.TransactionFeeSplitRatios.ForProposer = proposal.GetPortions().Fractions[0]
+		p.TransactionFeeSplitRatios.ForCompany = proposal.GetPortions().Fractions[1]
+		if err = p.Validate(); err == nil {
+			k.bankKeeper.SetParams(ctx, p)
+		}
+	case types.PROPOSAL_TYPE_ACCRUE_PERCENTAGE_RANGES:
+		err = errors.New("parameter is deprecated")
+	case types.PROPOSAL_TYPE_ACCRUE_PERCENTAGE_TABLE:
+		p := k.delegatingKeeper.GetParams(ctx)
+		p.AccruePercentageTable = proposal.GetAccruePercentageTable().AccruePercentageTable
+		if err = p.Validate(); err == nil {
 			k.delegatingKeeper.SetParams(ctx, p)
-		default:
-			err = errors.Errorf("unknown proposal type %d", proposal.Type)
 		}
-		if err != nil {
-			k.Logger(ctx).Error("could not apply voting result due to error",
-				"name", proposal.Name,
-				"error", err,
-			)
+	case types.PROPOSAL_TYPE_BLOCKED_SENDER_ADD:
+		k.bankKeeper.AddBlockedSender(ctx, proposal.GetAddress().GetAddress())
+	case types.PROPOSAL_TYPE_BLOCKED_SENDER_REMOVE:
+		k.bankKeeper.RemoveBlockedSender(ctx, proposal.GetAddress().GetAddress())
+	case types.PROPOSAL_TYPE_REVOKE:
+		p := k.delegatingKeeper.GetParams(ctx)
+		p.Revoke = *proposal.GetRevoke().Revoke
+		k.delegatingKeeper.SetParams(ctx, p)
+	case types.PROPOSAL_TYPE_EXPRESS_REVOKE:
+		p := k.delegatingKeeper.GetParams(ctx)
+		p.ExpressRevoke = *proposal.GetRevoke().Revoke
+		k.delegatingKeeper.SetParams(ctx, p)
+	case types.PROPOSAL_TYPE_UNJAIL_AFTER:
+		p := k.nodingKeeper.GetParams(ctx)
+		p.UnjailAfter = proposal.GetCount().Count
+		k.nodingKeeper.SetParams(ctx, p)
+	case types.PROPOSAL_TYPE_RENAME_PRICE:
+		p := k.profileKeeper.GetParams(ctx)
+		p.RenamePrice = proposal.GetMinAmount().MinAmount
+		k.profileKeeper.SetParams(ctx, p)
+	case types.PROPOSAL_TYPE_BASE_VPN_GB:
+		p := k.profileKeeper.GetParams(ctx)
+		p.BaseVpnGb = proposal.GetCount().Count
+		k.profileKeeper.SetParams(ctx, p)
+	case types.PROPOSAL_TYPE_BASE_STORAGE_GB:
+		p := k.profileKeeper.GetParams(ctx)
+		p.BaseStorageGb = proposal.GetCount().Count
+		k.profileKeeper.SetParams(ctx, p)
+	case types.PROPOSAL_TYPE_VOTING_PERIOD:
+		p := k.GetParams(ctx)
+		p.VotingPeriod = int32(proposal.GetCount().Count)
+		k.SetParams(ctx, p)
+	case types.PROPOSAL_TYPE_POLL_PERIOD:
+		p := k.GetParams(ctx)
+		p.PollPeriod = int32(proposal.GetCount().Count)
+		k.SetParams(ctx, p)
+	case types.PROPOSAL_TYPE_COMPANY_ACCOUNTS:
+		p := k.referralKeeper.GetParams(ctx)
+		p.CompanyAccounts = *proposal.GetCompanyAccounts().CompanyAccounts
+		k.referralKeeper.SetParams(ctx, p)
+	case types.PROPOSAL_TYPE_COMPANY_ACCOUNT:
+		p := k.bankKeeper.GetParams(ctx)
+		p.CompanyAccount = proposal.GetAddress().Address
+		k.bankKeeper.SetParams(ctx, p)
+	case types.PROPOSAL_TYPE_PROPOSAL_DEPOSIT:
+		p := k.GetParams(ctx)
+		p.ProposalDeposit = proposal.GetMinAmount().MinAmount
+		k.SetParams(ctx, p)
+	case types.PROPOSAL_TYPE_PARAM_CHANGE:
+		for i, change := range proposal.GetParamChange().Changes {
+			if err = k.applyParamChange(ctx, change); err != nil {
+				return errors.Wrapf(err, "cannot apply change #%d", i)
+			}
+		}
+	case types.PROPOSAL_TYPE_MULTI_CHANGE:
+		cacheCtx, write := ctx.CacheContext()
+		for i, change := range proposal.GetMultiChange().Changes {
+			change.Name, change.Author = proposal.Name, proposal.Author
+			if err = k.applyChange(cacheCtx, change); err != nil {
+				return errors.Wrapf(err, "cannot apply change #%d", i)
+			}
 		}
+		write()
+	default:
+		err = errors.Errorf("unknown proposal type %d", proposal.Type)
 	}
+	return err
 }
 
-func (k Keeper) ScheduleEnding(ctx sdk.Context, time time.Time) {
-	k.scheduleKeeper.ScheduleTask(ctx, time, types.VoteHookName, nil)
+// applyChange applies a part of a multi-change proposal turning a panic (e.g. caused by invalid params) into an error.
+func (k Keeper) applyChange(ctx sdk.Context, change types.Proposal) (err error) {
+	defer func() {
+		if e := recover(); e != nil {
+			if er, ok := e.(error); ok {
+				err = errors.Wrap(er, "panic")
+			} else {
+				err = errors.Errorf("panic: %v", e)
+			}
+		}
+	}()
+	return k.applyProposal(ctx, change)
 }
 
-func (k Keeper) ProcessSchedule(ctx sdk.Context, _ []byte, _ time.Time) {
-	proposal := k.GetCurrentProposal(ctx)
+// applyParamChange sets a module parameter through its x/params subspace. The value is checked by the validator the
+// module has registered for the key, and then the whole module parameter set is validated. An unregistered key makes
+// Subspace.Update panic, applyChange turns it into an error.
+func (k Keeper) applyParamChange(ctx sdk.Context, change types.ParamChange) error {
+	if change.IsImmutable() {
+		return errors.Errorf("%s cannot be changed", change.Target())
+	}
+	subspace, ok := k.subspaces[change.Subspace]
+	if !ok {
+		return errors.Errorf("unknown subspace %q", change.Subspace)
+	}
+	if err := subspace.Update(ctx, []byte(change.Key), []byte(change.Value)); err != nil {
+		return errors.Wrap(err, change.Target())
+	}
+	if err := k.validateParamSet(ctx, change.Subspace); err != nil {
+		return errors.Wrap(err, change.Target())
+	}
+	return nil
+}
 
-	if proposal != nil {
-		_, agree := k.Validate(
-			k.GetGovernment(ctx),
-			k.GetAgreed(ctx),
-			k.GetDisagreed(ctx),
-		)
+// validateParamSet checks the rules the module parameters must follow together, those a single key validator cannot.
+func (k Keeper) validateParamSet(ctx sdk.Context, subspace string) error {
+	var params interface{ Validate() error }
+	switch subspace {
+	case bank.ModuleName:
+		params = k.bankKeeper.GetParams(ctx)
+	case delegating.ModuleName:
+		params = k.delegatingKeeper.GetParams(ctx)
+	case noding.ModuleName:
+		p := k.nodingKeeper.GetParams(ctx)
+		params = &p
+	case profile.ModuleName:
+		params = k.profileKeeper.GetParams(ctx)
+	case referral.ModuleName:
+		params = k.referralKeeper.GetParams(ctx)
+	case types.ModuleName:
+		params = k.GetParams(ctx)
+	default:
+		return nil
+	}
+	return params.Validate()
+}
 
-		k.EndProposal(ctx, *proposal, agree)
+func (k Keeper) ScheduleEnding(ctx sdk.Context, time time.Time, id uint64) {
+	k.scheduleKeeper.ScheduleTask(ctx, time, types.VoteHookName, proposalIdKey(id))
+}
+
+func (k Keeper) ProcessSchedule(ctx sdk.Context, data []byte, _ time.Time) {
+	if len(data) != 8 {
+		k.Logger(ctx).Error("ProcessSchedule: unexpected payload", "data", data)
+		return
 	}
+	ap, ok := k.GetActiveProposal(ctx, binary.BigEndian.Uint64(data))
+	if !ok {
+		return
+	}
+
+	_, agree := k.GetParams(ctx).Decide(ap.Proposal.Categories(), len(k.GetGovernment(ctx).Members), ap.Tally(), true)
+	k.EndProposal(ctx, ap.Proposal, agree)
 }
 
 func (k Keeper) GetHistory(ctx sdk.Context, limit int32, page int32) []types.ProposalHistoryRecord {
@@ -435,10 +708,6 @@ func (k Keeper) GetHistory(ctx sdk.Context, limit int32, page int32) []types.Pro
 }
 
 func (k Keeper) Propose(ctx sdk.Context, msg types.MsgPropose) error {
-	if k.GetCurrentProposal(ctx) != nil {
-		return types.ErrOtherActive
-	}
-
 	var (
 		proposal = msg.Proposal
 		gov      = k.GetGovernment(ctx)
@@ -446,39 +715,76 @@ func (k Keeper) Propose(ctx sdk.Context, msg types.MsgPropose) error {
 	if !gov.Contains(proposal.GetAuthor()) {
 		return errors.Wrap(types.ErrSignerNotAllowed, msg.Proposal.Author)
 	}
+	if err := proposal.Validate(); err != nil {
+		return errors.Wrap(types.ErrProposalCannotBeApplied, err.Error())
+	}
+	if proposal.ActivateAt != nil && !proposal.ActivateAt.After(ctx.BlockTime()) {
+		return errors.Wrap(types.ErrProposalCannotBeApplied, "activation time must be in the future")
+	}
+	var conflict error
+	k.IterateActiveProposals(ctx, func(ap types.ActiveProposal) (stop bool) {
+		if proposal.ConflictsWith(ap.Proposal) {
+			conflict = errors.Wrapf(types.ErrOtherActive, "proposal #%d changes the same parameter", ap.Proposal.Id)
+			return true
+		}
+		return false
+	})
+	if conflict == nil {
+		k.IteratePendingChanges(ctx, func(pc types.PendingChange) (stop bool) {
+			if proposal.ConflictsWith(pc.Proposal) {
+				conflict = errors.Wrapf(types.ErrOtherActive, "agreed proposal #%d pending activation changes the same parameter", pc.Proposal.Id)
+				return true
+			}
+			return false
+		})
+	}
+	if conflict != nil {
+		return conflict
+	}
+	if err := k.checkApplicable(ctx, proposal); err != nil {
+		return err
+	}
 
 	params := k.GetParams(ctx)
 	endTime := ctx.BlockTime().Add(time.Duration(params.VotingPeriod) * time.Hour)
 	proposal.EndTime = &endTime
+	proposal.Id = k.allocateProposalId(ctx)
 
-	// Set proposal
-	k.SetCurrentProposal(ctx, proposal)
+	if params.ProposalDeposit > 0 {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, proposal.GetAuthor(), types.ModuleName, util.Uartrs(params.ProposalDeposit)); err != nil {
+			return errors.Wrap(err, "cannot take proposal deposit")
+		}
+	}
 
-	// Set empty lists of voters
-	agreed, disagreed := types.Government{Members: []string{proposal.Author}}, types.Government{}
-	k.SetAgreed(ctx, agreed)
-	k.SetDisagreed(ctx, disagreed)
-	k.ScheduleEnding(ctx, endTime)
-	k.SetStartBlock(ctx)
+	// Set proposal along with the lists of voters
+	ap := types.ActiveProposal{
+		Proposal: proposal,
+		Agreed:   []string{proposal.Author},
+		Started:  ctx.BlockHeight(),
+		Deposit:  params.ProposalDeposit,
+	}
+	k.SetActiveProposal(ctx, ap)
+	k.ScheduleEnding(ctx, endTime, proposal.Id)
 
 	util.EmitEvent(ctx,
 		&types.EventProposalCreated{
 			Name:   proposal.Name,
 			Author: proposal.Author,
 			Type:   proposal.Type,
+			Id:     proposal.Id,
 		},
 	)
 
-	if complete, agree := k.Validate(gov, agreed, disagreed); complete {
+	if complete, agree := k.Validate(ctx, ap, gov); complete {
 		k.EndProposal(ctx, proposal, agree)
 	}
 	return nil
 }
 
-func (k Keeper) Vote(ctx sdk.Context, voter sdk.AccAddress, agree bool) error {
-	proposal := k.GetCurrentProposal(ctx)
-	if proposal == nil {
-		return types.ErrNoActiveProposals
+func (k Keeper) Vote(ctx sdk.Context, voter sdk.AccAddress, id uint64, option types.VoteOption) error {
+	ap, ok := k.GetActiveProposal(ctx, id)
+	if !ok {
+		return errors.Wrapf(types.ErrNoActiveProposals, "proposal #%d", id)
 	}
 
 	gov := k.GetGovernment(ctx)
@@ -486,41 +792,174 @@ func (k Keeper) Vote(ctx sdk.Context, voter sdk.AccAddress, agree bool) error {
 		return errors.Wrap(types.ErrSignerNotAllowed, voter.String())
 	}
 
-	agreed := k.GetAgreed(ctx)
-	if agreed.Contains(voter) {
+	if ap.VoteOf(voter) != types.VOTE_OPTION_UNSPECIFIED {
 		return errors.Wrap(types.ErrAlreadyVoted, voter.String())
 	}
 
-	disagreed := k.GetDisagreed(ctx)
-	if disagreed.Contains(voter) {
-		return sdkerrors.Wrap(types.ErrAlreadyVoted, voter.String())
+	voters := ap.Voters(option)
+	if voters == nil {
+		return errors.Errorf("invalid vote option: %s", option)
 	}
+	*voters = append(*voters, voter.String())
+	k.SetActiveProposal(ctx, ap)
 
-	if agree {
-		agreed.Append(voter)
-		k.SetAgreed(ctx, agreed)
-	} else {
-		disagreed.Append(voter)
-		k.SetDisagreed(ctx, disagreed)
+	util.EmitEvent(ctx,
+		&types.EventProposalVote{
+			Voter:      voter.String(),
+			Agreed:     option == types.VOTE_OPTION_YES,
+			ProposalId: id,
+			Option:     option,
+		},
+	)
+
+	if complete, agree := k.Validate(ctx, ap, gov); complete {
+		k.EndProposal(ctx, ap.Proposal, agree)
 	}
+	return nil
+}
+
+// ChangeVote moves a governor who has already voted for an open proposal to another option.
+func (k Keeper) ChangeVote(ctx sdk.Context, voter sdk.AccAddress, id uint64, option types.VoteOption) error {
+	ap, ok := k.GetActiveProposal(ctx, id)
+	if !ok {
+		return errors.Wrapf(types.ErrNoActiveProposals, "proposal #%d", id)
+	}
+
+	gov := k.GetGovernment(ctx)
+	if !gov.Contains(voter) {
+		return errors.Wrap(types.ErrSignerNotAllowed, voter.String())
+	}
+
+	to := ap.Voters(option)
+	if to == nil {
+		return errors.Errorf("invalid vote option: %s", option)
+	}
+	prev := ap.VoteOf(voter)
+	if prev == types.VOTE_OPTION_UNSPECIFIED {
+		return errors.Wrap(types.ErrNotVoted, voter.String())
+	}
+	if prev == option {
+		return errors.Wrap(types.ErrAlreadyVoted, "the same way")
+	}
+
+	from := types.Government{Members: *ap.Voters(prev)}
+	from.Remove(voter)
+	*ap.Voters(prev) = from.Members
+	*to = append(*to, voter.String())
+	k.SetActiveProposal(ctx, ap)
 
 	util.EmitEvent(ctx,
-		&types.EventProposalVote{
-			Voter:  voter.String(),
-			Agreed: agree,
+		&types.EventProposalVoteChanged{
+			Voter:      voter.String(),
+			Agreed:     option == types.VOTE_OPTION_YES,
+			ProposalId: id,
+			Option:     option,
 		},
 	)
 
-	if complete, agree := k.Validate(gov, agreed, disagreed); complete {
-		k.EndProposal(ctx, *proposal, agree)
+	if complete, agree := k.Validate(ctx, ap, gov); complete {
+		k.EndProposal(ctx, ap.Proposal, agree)
 	}
 	return nil
 }
 
-func (k Keeper) GetCurrentPoll(ctx sdk.Context) (poll types.Poll, ok bool) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPollPrefix)
-	bz := store.Get(types.KeyPollCurrent)
+// MigrateLegacyProposal moves the only proposal that could be active before proposals got their IDs to the new store
+// layout (if there is such a proposal).
+func (k Keeper) MigrateLegacyProposal(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyCurrentVote)
+	if bz == nil {
+		return
+	}
+
+	var (
+		proposal         types.Proposal
+		agreed, disagree types.Government
+	)
+	if err := proto.Unmarshal(bz, &proposal); err != nil {
+		panic(err)
+	}
+	if bz := store.Get(types.KeyAgreedMembers); bz != nil {
+		if err := proto.Unmarshal(bz, &agreed); err != nil {
+			panic(err)
+		}
+	}
+	if bz := store.Get(types.KeyDisagreedMembers); bz != nil {
+		if err := proto.Unmarshal(bz, &disagree); err != nil {
+			panic(err)
+		}
+	}
+	var started int64
+	if bz := store.Get(types.KeyStartBlock); bz != nil {
+		started = int64(binary.BigEndian.Uint64(bz))
+	}
+
+	store.Delete(types.KeyCurrentVote)
+	store.Delete(types.KeyAgreedMembers)
+	store.Delete(types.KeyDisagreedMembers)
+	store.Delete(types.KeyStartBlock)
 
+	k.importLegacyProposal(ctx, types.ActiveProposal{
+		Proposal:  proposal,
+		Agreed:    agreed.Members,
+		Disagreed: disagree.Members,
+		Started:   started,
+	})
+}
+
+// importLegacyProposal assigns an ID to a proposal started before proposals got their IDs and replaces its completion
+// task with a new one, that refers the proposal by its ID.
+func (k Keeper) importLegacyProposal(ctx sdk.Context, ap types.ActiveProposal) {
+	ap.Proposal.Id = k.allocateProposalId(ctx)
+	if ap.Proposal.EndTime == nil {
+		endTime := ctx.BlockTime().Add(time.Duration(k.GetParams(ctx).VotingPeriod) * time.Hour)
+		ap.Proposal.EndTime = &endTime
+	} else {
+		k.scheduleKeeper.Delete(ctx, *ap.Proposal.EndTime, types.VoteHookName, nil)
+	}
+	k.SetActiveProposal(ctx, ap)
+	k.ScheduleEnding(ctx, *ap.Proposal.EndTime, ap.Proposal.Id)
+}
+
+// LoadProposals initializes the proposals state from genesis.
+func (k Keeper) LoadProposals(ctx sdk.Context, state types.GenesisState) {
+	if state.NextProposalId != 0 {
+		k.SetNextProposalId(ctx, state.NextProposalId)
+	} else {
+		k.SetNextProposalId(ctx, 1)
+	}
+	for _, ap := range state.ActiveProposals {
+		k.SetActiveProposal(ctx, ap)
+	}
+	if state.CurrentProposal.Type != types.PROPOSAL_TYPE_UNSPECIFIED {
+		k.importLegacyProposal(ctx, types.ActiveProposal{
+			Proposal:  state.CurrentProposal,
+			Agreed:    state.Agreed,
+			Disagreed: state.Disagreed,
+			Started:   state.StartBlock,
+		})
+	}
+	for _, record := range state.History {
+		k.AddProposalHistoryRecord(ctx, record)
+	}
+	for _, pc := range state.PendingChanges {
+		k.setPendingChange(ctx, pc)
+	}
+}
+
+func (k Keeper) pollsStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPollPrefix)
+}
+
+// pollStore returns a store of an active poll's own data: its answers, counters, weights and commitments.
+func (k Keeper) pollStore(ctx sdk.Context, id uint64) prefix.Store {
+	return prefix.NewStore(prefix.NewStore(k.pollsStore(ctx), types.KeyPollData), sdk.Uint64ToBigEndian(id))
+}
+
+// GetPoll returns a poll being held at the moment by its ID.
+func (k Keeper) GetPoll(ctx sdk.Context, id uint64) (poll types.Poll, ok bool) {
+	store := prefix.NewStore(k.pollsStore(ctx), types.KeyPollActive)
+	bz := store.Get(sdk.Uint64ToBigEndian(id))
 	if bz == nil {
 		return types.Poll{}, false
 	}
@@ -529,8 +968,61 @@ func (k Keeper) GetCurrentPoll(ctx sdk.Context) (poll types.Poll, ok bool) {
 	return poll, true
 }
 
-func (k Keeper) GetPollStatus(ctx sdk.Context) (yes, no uint64) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPollPrefix)
+func (k Keeper) SetPoll(ctx sdk.Context, poll types.Poll) {
+	store := prefix.NewStore(k.pollsStore(ctx), types.KeyPollActive)
+	store.Set(sdk.Uint64ToBigEndian(poll.Id), k.cdc.MustMarshalBinaryBare(&poll))
+}
+
+func (k Keeper) deletePoll(ctx sdk.Context, id uint64) {
+	store := prefix.NewStore(k.pollsStore(ctx), types.KeyPollActive)
+	store.Delete(sdk.Uint64ToBigEndian(id))
+}
+
+// IterateActivePolls calls the callback for every poll being held at the moment in the ID order.
+func (k Keeper) IterateActivePolls(ctx sdk.Context, callback func(poll types.Poll) (stop bool)) {
+	it := sdk.KVStorePrefixIterator(k.pollsStore(ctx), types.KeyPollActive)
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		var poll types.Poll
+		k.cdc.MustUnmarshalBinaryBare(it.Value(), &poll)
+		if callback(poll) {
+			return
+		}
+	}
+}
+
+// GetActivePolls returns all the polls being held at the moment.
+func (k Keeper) GetActivePolls(ctx sdk.Context) []types.Poll {
+	var res []types.Poll
+	k.IterateActivePolls(ctx, func(poll types.Poll) (stop bool) {
+		res = append(res, poll)
+		return false
+	})
+	return res
+}
+
+// GetNextPollId returns an ID the next poll is going to get.
+func (k Keeper) GetNextPollId(ctx sdk.Context) uint64 {
+	bz := k.pollsStore(ctx).Get(types.KeyPollNextId)
+	if bz == nil {
+		return 1
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) SetNextPollId(ctx sdk.Context, id uint64) {
+	k.pollsStore(ctx).Set(types.KeyPollNextId, sdk.Uint64ToBigEndian(id))
+}
+
+func (k Keeper) allocatePollId(ctx sdk.Context) uint64 {
+	id := k.GetNextPollId(ctx)
+	k.SetNextPollId(ctx, id+1)
+	return id
+}
+
+func (k Keeper) GetPollStatus(ctx sdk.Context, id uint64) (yes, no uint64) {
+	store := k.pollStore(ctx, id)
 	if bz := store.Get(types.KeyPollYesCount); bz != nil {
 		yes = binary.BigEndian.Uint64(bz)
 	}
@@ -540,32 +1032,214 @@ func (k Keeper) GetPollStatus(ctx sdk.Context) (yes, no uint64) {
 	return
 }
 
-func (k Keeper) StartPoll(ctx sdk.Context, poll types.Poll) error {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPollPrefix)
+// GetPollTallies returns numbers of answers (or stake for a stake-weighted poll) per option of an active poll if it's
+// a multi-choice one.
+func (k Keeper) GetPollTallies(ctx sdk.Context, id uint64) []uint64 {
+	poll, ok := k.GetPoll(ctx, id)
+	if !ok {
+		return nil
+	}
+	return pollTallies(k.pollStore(ctx, id), poll)
+}
 
-	if store.Has(types.KeyPollCurrent) {
-		return types.ErrOtherActive
+func pollTallies(store sdk.KVStore, poll types.Poll) []uint64 {
+	if !poll.IsMultiChoice() {
+		return nil
 	}
+	tallies := make([]uint64, len(poll.Options))
+	for i := range tallies {
+		if bz := store.Get(pollTallyKey(uint32(i + 1))); bz != nil {
+			tallies[i] = binary.BigEndian.Uint64(bz)
+		}
+	}
+	return tallies
+}
+
+// GetPollWeight returns the respondent's delegated stake as of the active poll start (0 if there's no such poll).
+func (k Keeper) GetPollWeight(ctx sdk.Context, id uint64, acc string) uint64 {
+	store := prefix.NewStore(k.pollStore(ctx, id), types.KeyPollWeights)
+	if bz := store.Get([]byte(acc)); bz != nil {
+		return binary.BigEndian.Uint64(bz)
+	}
+	if _, ok := k.GetPoll(ctx, id); !ok {
+		return 0
+	}
+
+	// The stake is not saved, so it hasn't been changed since the poll start
+	addr, err := sdk.AccAddressFromBech32(acc)
+	if err != nil {
+		panic(errors.Wrap(err, "cannot parse acc address"))
+	}
+	return k.bankKeeper.GetBalance(ctx, addr).AmountOf(util.ConfigDelegatedDenom).Uint64()
+}
+
+func (k Keeper) setPollWeight(ctx sdk.Context, id uint64, acc string, weight uint64) {
+	store := prefix.NewStore(k.pollStore(ctx, id), types.KeyPollWeights)
+	store.Set([]byte(acc), sdk.Uint64ToBigEndian(weight))
+}
+
+func (k Keeper) IteratePollWeights(ctx sdk.Context, id uint64, callback func(acc string, weight uint64) (stop bool)) {
+	store := prefix.NewStore(k.pollStore(ctx, id), types.KeyPollWeights)
+	it := store.Iterator(nil, nil)
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		if callback(string(it.Key()), binary.BigEndian.Uint64(it.Value())) {
+			return
+		}
+	}
+}
+
+// OnBeforeBalanceChanged saves the account's delegated stake for every active stake-weighted poll unless it's saved
+// already. So only the stake as of a poll start counts, no matter how coins are moved during the poll.
+func (k Keeper) OnBeforeBalanceChanged(ctx sdk.Context, addr sdk.AccAddress) error {
+	var (
+		acc       = addr.String()
+		delegated *uint64
+	)
+	k.IterateActivePolls(ctx, func(poll types.Poll) (stop bool) {
+		if !poll.StakeWeighted {
+			return false
+		}
+		store := prefix.NewStore(k.pollStore(ctx, poll.Id), types.KeyPollWeights)
+		if store.Has([]byte(acc)) {
+			return false
+		}
+		if delegated == nil {
+			x := k.bankKeeper.GetBalance(ctx, addr).AmountOf(util.ConfigDelegatedDenom).Uint64()
+			delegated = &x
+		}
+		k.setPollWeight(ctx, poll.Id, acc, *delegated)
+		return false
+	})
+	return nil
+}
+
+func pollTallyKey(option uint32) []byte {
+	key := make([]byte, len(types.KeyPollTallies)+4)
+	copy(key, types.KeyPollTallies)
+	binary.BigEndian.PutUint32(key[len(types.KeyPollTallies):], option)
+	return key
+}
+
+func (k Keeper) schedulePollEnding(ctx sdk.Context, poll types.Poll) {
+	k.scheduleKeeper.ScheduleTask(ctx, poll.FinishTime(), types.PollHookName, sdk.Uint64ToBigEndian(poll.Id))
+}
+
+func (k Keeper) StartPoll(ctx sdk.Context, poll types.Poll) error {
 	if !util.ContainsString(k.GetGovernment(ctx).Strings(), poll.Author) {
 		return types.ErrSignerNotAllowed
 	}
 
+	params := k.GetParams(ctx)
+	period := params.PollPeriod
+	if poll.Period != 0 {
+		if poll.Period < params.MinPollPeriod || poll.Period > params.MaxPollPeriod {
+			return errors.Wrapf(types.ErrPollPeriod, "must be from %d to %d hours", params.MinPollPeriod, params.MaxPollPeriod)
+		}
+		period = poll.Period
+	}
+
+	poll.Id = k.allocatePollId(ctx)
 	start := ctx.BlockTime()
-	end := start.Add(time.Duration(k.GetParams(ctx).PollPeriod) * time.Hour)
-	k.scheduleKeeper.ScheduleTask(ctx, end, types.PollHookName, nil)
+	end := start.Add(time.Duration(period) * time.Hour)
 	poll.StartTime = &start
 	poll.EndTime = &end
+	if poll.Secret {
+		revealEnd := end.Add(time.Duration(params.RevealPeriod) * time.Hour)
+		poll.RevealEndTime = &revealEnd
+	}
+
+	k.SetPoll(ctx, poll)
+	k.schedulePollEnding(ctx, poll)
+	return nil
+}
+
+// Answer records a respondent's answer to an active poll. The option (1-based) is used for a multi-choice poll
+// instead of the yes flag. A secret poll doesn't accept open answers, see CommitAnswer and RevealAnswer.
+func (k Keeper) Answer(ctx sdk.Context, id uint64, acc string, yes bool, option uint32) error {
+	poll, ok := k.GetPoll(ctx, id)
+	if !ok {
+		return errors.Wrapf(types.ErrNoActivePoll, "poll #%d", id)
+	}
+	if poll.Secret {
+		return errors.Wrap(types.ErrPollMode, "the poll is secret, commit an answer hash instead")
+	}
+
+	return k.answer(ctx, poll, acc, yes, option)
+}
+
+// CommitAnswer saves a respondent's answer hash (see types.PollCommitmentHash) to an active secret poll. It's possible
+// until the poll end time only.
+func (k Keeper) CommitAnswer(ctx sdk.Context, id uint64, acc string, hash []byte) error {
+	poll, ok := k.GetPoll(ctx, id)
+	if !ok {
+		return errors.Wrapf(types.ErrNoActivePoll, "poll #%d", id)
+	}
+	if !poll.Secret {
+		return errors.Wrap(types.ErrPollMode, "the poll is not secret, answer it directly")
+	}
+	if !ctx.BlockTime().Before(*poll.EndTime) {
+		return errors.Wrap(types.ErrPollPhase, "commit phase is over")
+	}
+	if _, err := k.respondentWeight(ctx, poll, acc); err != nil {
+		return err
+	}
 
-	store.Set(types.KeyPollCurrent, k.cdc.MustMarshalBinaryBare(&poll))
+	store := prefix.NewStore(k.pollStore(ctx, id), types.KeyPollCommitments)
+	key := []byte(acc)
+	if store.Has(key) {
+		return types.ErrAlreadyVoted
+	}
+	store.Set(key, hash)
 	return nil
 }
 
-func (k Keeper) Answer(ctx sdk.Context, acc string, yes bool) error {
-	poll, ok := k.GetCurrentPoll(ctx)
+// RevealAnswer counts an answer previously committed to an active secret poll. It's possible after the poll end
+// time and until its reveal end time, and only if the answer and the salt match the commitment.
+func (k Keeper) RevealAnswer(ctx sdk.Context, id uint64, acc string, yes bool, option uint32, salt string) error {
+	poll, ok := k.GetPoll(ctx, id)
 	if !ok {
-		return types.ErrNoActivePoll
+		return errors.Wrapf(types.ErrNoActivePoll, "poll #%d", id)
+	}
+	if !poll.Secret {
+		return errors.Wrap(types.ErrPollMode, "the poll is not secret, answer it directly")
+	}
+	if now := ctx.BlockTime(); now.Before(*poll.EndTime) || !now.Before(*poll.RevealEndTime) {
+		return errors.Wrap(types.ErrPollPhase, "not a reveal phase")
+	}
+
+	hash := k.GetPollCommitment(ctx, id, acc)
+	if hash == nil {
+		return types.ErrNotVoted
+	}
+	if !bytes.Equal(hash, types.PollCommitmentHash(acc, yes, option, salt)) {
+		return types.ErrCommitmentMismatch
 	}
 
+	return k.answer(ctx, poll, acc, yes, option)
+}
+
+// GetPollCommitment returns the respondent's answer hash committed to an active secret poll (or nil).
+func (k Keeper) GetPollCommitment(ctx sdk.Context, id uint64, acc string) []byte {
+	store := prefix.NewStore(k.pollStore(ctx, id), types.KeyPollCommitments)
+	return store.Get([]byte(acc))
+}
+
+func (k Keeper) IteratePollCommitments(ctx sdk.Context, id uint64, callback func(acc string, hash []byte) (stop bool)) {
+	store := prefix.NewStore(k.pollStore(ctx, id), types.KeyPollCommitments)
+	it := store.Iterator(nil, nil)
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		if callback(string(it.Key()), it.Value()) {
+			break
+		}
+	}
+}
+
+// respondentWeight checks if the account is allowed to answer the poll and returns its answer weight.
+func (k Keeper) respondentWeight(ctx sdk.Context, poll types.Poll, acc string) (uint64, error) {
 	addr, err := sdk.AccAddressFromBech32(acc)
 	if err != nil {
 		panic(errors.Wrap(err, "cannot parse acc address"))
@@ -578,7 +1252,7 @@ func (k Keeper) Answer(ctx sdk.Context, acc string, yes bool) error {
 			panic(errors.Wrap(err, "cannot check for qualification"))
 		}
 		if !q {
-			return types.ErrRespondentNotAllowed
+			return 0, types.ErrRespondentNotAllowed
 		}
 	case *types.Poll_MinStatus:
 		info, err := k.referralKeeper.Get(ctx, acc)
@@ -586,11 +1260,26 @@ func (k Keeper) Answer(ctx sdk.Context, acc string, yes bool) error {
 			panic(errors.Wrap(err, "cannot obtain referral info"))
 		}
 		if info.Status < r.MinStatus {
-			return types.ErrRespondentNotAllowed
+			return 0, types.ErrRespondentNotAllowed
 		}
 	}
 
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPollPrefix)
+	weight := uint64(1)
+	if poll.StakeWeighted {
+		if weight = k.GetPollWeight(ctx, poll.Id, acc); weight == 0 {
+			return 0, errors.Wrap(types.ErrRespondentNotAllowed, "no delegation at the poll start")
+		}
+	}
+	return weight, nil
+}
+
+func (k Keeper) answer(ctx sdk.Context, poll types.Poll, acc string, yes bool, option uint32) error {
+	weight, err := k.respondentWeight(ctx, poll, acc)
+	if err != nil {
+		return err
+	}
+
+	store := k.pollStore(ctx, poll.Id)
 	ansStore := prefix.NewStore(store, types.KeyPollAnswers)
 	key := []byte(acc)
 	if ansStore.Has(key) {
@@ -598,7 +1287,14 @@ func (k Keeper) Answer(ctx sdk.Context, acc string, yes bool) error {
 	}
 
 	var ans, countKey []byte
-	if yes {
+	if poll.IsMultiChoice() {
+		if option == 0 || option > uint32(len(poll.Options)) {
+			return errors.Wrapf(types.ErrInvalidPollOption, "%d options available", len(poll.Options))
+		}
+		ans = make([]byte, 4)
+		binary.BigEndian.PutUint32(ans, option)
+		countKey = pollTallyKey(option)
+	} else if yes {
 		ans = types.ValueYes
 		countKey = types.KeyPollYesCount
 	} else {
@@ -607,42 +1303,80 @@ func (k Keeper) Answer(ctx sdk.Context, acc string, yes bool) error {
 	}
 	ansStore.Set(key, ans)
 
-	var (
-		bz    []byte
-		value uint64
-	)
-	if bz = store.Get(countKey); bz != nil {
+	var value uint64
+	if bz := store.Get(countKey); bz != nil {
 		value = binary.BigEndian.Uint64(bz)
-	} else {
-		bz = make([]byte, 8)
 	}
-	value += 1
-	binary.BigEndian.PutUint64(bz, value)
-	store.Set(countKey, bz)
+	store.Set(countKey, sdk.Uint64ToBigEndian(value+weight))
 
 	return nil
 }
 
-func (k Keeper) EndPollHandler(ctx sdk.Context, _ []byte, _ time.Time) { k.EndPoll(ctx) }
-func (k Keeper) EndPoll(ctx sdk.Context) {
-	store := cachekv.NewStore(prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPollPrefix))
+// CancelPoll withdraws an active poll at once if the signer is its author. Otherwise, the signer must be a government
+// member, and the poll is cancelled as soon as the majority of the government asks for that.
+func (k Keeper) CancelPoll(ctx sdk.Context, id uint64, signer string) error {
+	poll, ok := k.GetPoll(ctx, id)
+	if !ok {
+		return errors.Wrapf(types.ErrNoActivePoll, "poll #%d", id)
+	}
 
-	var (
-		poll     types.Poll
-		yes, no  uint64
-		decision types.Decision
-	)
-	if bz := store.Get(types.KeyPollCurrent); bz != nil {
-		k.cdc.MustUnmarshalBinaryBare(bz, &poll)
-	} else {
-		panic(types.ErrNoActivePoll)
+	if signer != poll.Author {
+		gov := k.GetGovernment(ctx).Strings()
+		if !util.ContainsString(gov, signer) {
+			return types.ErrSignerNotAllowed
+		}
+		if util.ContainsString(poll.CancelVotes, signer) {
+			return types.ErrAlreadyVoted
+		}
+		poll.CancelVotes = append(poll.CancelVotes, signer)
+
+		var votes int
+		for _, acc := range poll.CancelVotes {
+			if util.ContainsString(gov, acc) {
+				votes++
+			}
+		}
+		if 2*votes <= len(gov) {
+			k.SetPoll(ctx, poll)
+			return nil
+		}
 	}
-	if bz := store.Get(types.KeyPollYesCount); bz != nil {
-		yes = binary.BigEndian.Uint64(bz)
+
+	k.scheduleKeeper.Delete(ctx, poll.FinishTime(), types.PollHookName, sdk.Uint64ToBigEndian(id))
+	k.finishPoll(ctx, types.PollHistoryItem{
+		Poll:      poll,
+		Cancelled: true,
+	})
+
+	util.EmitEvent(ctx,
+		&types.EventPollCancelled{
+			Id:          id,
+			Name:        poll.Name,
+			CancelledBy: signer,
+		},
+	)
+	return nil
+}
+
+func (k Keeper) EndPollHandler(ctx sdk.Context, data []byte, _ time.Time) {
+	if len(data) != 8 {
+		k.Logger(ctx).Error("EndPollHandler: unexpected payload", "data", data)
+		return
 	}
-	if bz := store.Get(types.KeyPollNoCount); bz != nil {
-		no = binary.BigEndian.Uint64(bz)
+	k.EndPoll(ctx, binary.BigEndian.Uint64(data))
+}
+
+func (k Keeper) EndPoll(ctx sdk.Context, id uint64) {
+	poll, ok := k.GetPoll(ctx, id)
+	if !ok {
+		panic(errors.Wrapf(types.ErrNoActivePoll, "poll #%d", id))
 	}
+
+	var (
+		store    = k.pollStore(ctx, id)
+		decision types.Decision
+	)
+	yes, no := k.GetPollStatus(ctx, id)
 	if poll.Quorum != nil {
 		if yes != 0 && util.FractionInt(int64(yes)).GTE(poll.Quorum.Mul(util.FractionInt(int64(yes+no)))) {
 			decision = types.DECISION_POSITIVE
@@ -650,6 +1384,7 @@ func (k Keeper) EndPoll(ctx sdk.Context) {
 			decision = types.DECISION_NEGATIVE
 		}
 	}
+	tallies := pollTallies(store, poll)
 
 	util.EmitEvent(ctx,
 		&types.EventPollFinished{
@@ -657,36 +1392,62 @@ func (k Keeper) EndPoll(ctx sdk.Context) {
 			Yes:      yes,
 			No:       no,
 			Decision: decision,
+			Tallies:  tallies,
+			Id:       id,
 		},
 	)
 
-	historyKey := make([]byte, len(types.KeyPollHistory)+8)
-	copy(historyKey, types.KeyPollHistory)
-	binary.BigEndian.PutUint64(historyKey[len(types.KeyPollHistory):], uint64(poll.EndTime.Unix()))
-	store.Set(historyKey, k.cdc.MustMarshalBinaryBare(&types.PollHistoryItem{
+	k.finishPoll(ctx, types.PollHistoryItem{
 		Poll:     poll,
 		Yes:      yes,
 		No:       no,
 		Decision: decision,
-	}))
+		Tallies:  tallies,
+	})
+}
 
-	store.Delete(types.KeyPollCurrent)
-	store.Delete(types.KeyPollYesCount)
-	store.Delete(types.KeyPollNoCount)
-	it := sdk.KVStorePrefixIterator(store, types.KeyPollAnswers)
+// finishPoll moves a poll to the history and clears its data.
+func (k Keeper) finishPoll(ctx sdk.Context, item types.PollHistoryItem) {
+	k.AddPollHistoryItem(ctx, item)
+	k.deletePoll(ctx, item.Poll.Id)
+	clearStore(k.pollStore(ctx, item.Poll.Id))
+}
+
+func clearStore(store sdk.KVStore) {
+	it := store.Iterator(nil, nil)
+	var keys [][]byte
 	for ; it.Valid(); it.Next() {
-		store.Delete(it.Key())
+		keys = append(keys, it.Key())
 	}
 	it.Close()
 
-	store.Write()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+func (k Keeper) AddPollHistoryItem(ctx sdk.Context, item types.PollHistoryItem) {
+	store := prefix.NewStore(k.pollsStore(ctx), types.KeyPollHistory)
+	store.Set(sdk.Uint64ToBigEndian(item.Poll.Id), k.cdc.MustMarshalBinaryBare(&item))
+}
+
+// GetPollHistoryItem returns a completed (or cancelled) poll by its ID.
+func (k Keeper) GetPollHistoryItem(ctx sdk.Context, id uint64) (item types.PollHistoryItem, ok bool) {
+	store := prefix.NewStore(k.pollsStore(ctx), types.KeyPollHistory)
+	bz := store.Get(sdk.Uint64ToBigEndian(id))
+	if bz == nil {
+		return types.PollHistoryItem{}, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &item)
+	return item, true
 }
 
 func (k Keeper) GetPollHistoryAll(ctx sdk.Context) []types.PollHistoryItem {
 	return k.GetPollHistory(ctx, 0, 0)
 }
 func (k Keeper) GetPollHistory(ctx sdk.Context, limit int32, page int32) []types.PollHistoryItem {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPollPrefix)
+	store := k.pollsStore(ctx)
 	var (
 		it  sdk.Iterator
 		res []types.PollHistoryItem
@@ -706,13 +1467,16 @@ func (k Keeper) GetPollHistory(ctx sdk.Context, limit int32, page int32) []types
 	return res
 }
 
-func (k Keeper) IterateThroughCurrentPollAnswers(ctx sdk.Context, callback func(acc string, ans bool) (stop bool)) (err error) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPollPrefix)
-	if !store.Has(types.KeyPollCurrent) {
-		return types.ErrNoActivePoll
+func (k Keeper) IteratePollAnswers(
+	ctx sdk.Context,
+	id uint64,
+	callback func(acc string, ans bool, option uint32) (stop bool),
+) (err error) {
+	if _, ok := k.GetPoll(ctx, id); !ok {
+		return errors.Wrapf(types.ErrNoActivePoll, "poll #%d", id)
 	}
 
-	it := sdk.KVStorePrefixIterator(store, types.KeyPollAnswers)
+	it := prefix.NewStore(k.pollStore(ctx, id), types.KeyPollAnswers).Iterator(nil, nil)
 	defer func() {
 		it.Close()
 		if e := recover(); e != nil {
@@ -725,31 +1489,128 @@ func (k Keeper) IterateThroughCurrentPollAnswers(ctx sdk.Context, callback func(
 	}()
 
 	for ; it.Valid(); it.Next() {
-		acc := string(it.Key()[len(types.KeyPollAnswers):])
-		ans := bytes.Equal(it.Value(), types.ValueYes)
-		if stop := callback(acc, ans); stop {
+		acc := string(it.Key())
+		var (
+			ans    bool
+			option uint32
+		)
+		if len(it.Value()) == 4 {
+			option = binary.BigEndian.Uint32(it.Value())
+		} else {
+			ans = bytes.Equal(it.Value(), types.ValueYes)
+		}
+		if stop := callback(acc, ans, option); stop {
 			return nil
 		}
 	}
 	return nil
 }
 
+// MigrateLegacyPolls moves the poll history and the only poll that could be active before polls got their IDs (if
+// there is such a poll) to the new store layout.
+func (k Keeper) MigrateLegacyPolls(ctx sdk.Context) {
+	store := k.pollsStore(ctx)
+
+	legacyHistory := prefix.NewStore(store, types.KeyPollLegacyHistory)
+	var history []types.PollHistoryItem
+	it := legacyHistory.Iterator(nil, nil)
+	for ; it.Valid(); it.Next() {
+		var item types.PollHistoryItem
+		k.cdc.MustUnmarshalBinaryBare(it.Value(), &item)
+		history = append(history, item)
+	}
+	it.Close()
+	clearStore(legacyHistory)
+	for _, item := range history {
+		item.Poll.Id = k.allocatePollId(ctx)
+		k.AddPollHistoryItem(ctx, item)
+	}
+
+	bz := store.Get(types.KeyPollCurrent)
+	if bz == nil {
+		return
+	}
+	var poll types.Poll
+	k.cdc.MustUnmarshalBinaryBare(bz, &poll)
+	store.Delete(types.KeyPollCurrent)
+	data := k.pollStore(ctx, k.importLegacyPoll(ctx, poll))
+
+	for _, key := range [][]byte{types.KeyPollYesCount, types.KeyPollNoCount} {
+		if bz := store.Get(key); bz != nil {
+			data.Set(key, bz)
+			store.Delete(key)
+		}
+	}
+	for _, pfx := range [][]byte{types.KeyPollAnswers, types.KeyPollWeights, types.KeyPollTallies, types.KeyPollCommitments} {
+		from := prefix.NewStore(store, pfx)
+		to := prefix.NewStore(data, pfx)
+
+		it := from.Iterator(nil, nil)
+		var keys, values [][]byte
+		for ; it.Valid(); it.Next() {
+			keys = append(keys, it.Key())
+			values = append(values, it.Value())
+		}
+		it.Close()
+
+		for i, key := range keys {
+			to.Set(key, values[i])
+			from.Delete(key)
+		}
+	}
+}
+
+// importLegacyPoll assigns an ID to a poll started before polls got their IDs and replaces its completion task with a
+// new one, that refers the poll by its ID.
+func (k Keeper) importLegacyPoll(ctx sdk.Context, poll types.Poll) uint64 {
+	poll.Id = k.allocatePollId(ctx)
+	k.scheduleKeeper.Delete(ctx, poll.FinishTime(), types.PollHookName, nil)
+	k.SetPoll(ctx, poll)
+	k.schedulePollEnding(ctx, poll)
+	return poll.Id
+}
+
+// LoadPolls initializes the polls state from genesis.
 func (k Keeper) LoadPolls(ctx sdk.Context, state types.GenesisState) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPollPrefix)
+	if state.NextPollId != 0 {
+		k.SetNextPollId(ctx, state.NextPollId)
+	} else {
+		k.SetNextPollId(ctx, 1)
+	}
+	for _, item := range state.PollHistory {
+		if item.Poll.Id == 0 {
+			item.Poll.Id = k.allocatePollId(ctx)
+		}
+		k.AddPollHistoryItem(ctx, item)
+	}
 
+	for _, poll := range state.ActivePolls {
+		k.SetPoll(ctx, poll)
+	}
+	var legacyId uint64
 	if state.CurrentPoll != nil {
-		store.Set(types.KeyPollCurrent, k.cdc.MustMarshalBinaryBare(state.CurrentPoll))
-		for _, ans := range state.PollAnswers {
-			if err := k.Answer(ctx, ans.Acc, ans.Ans); err != nil {
-				panic(err)
-			}
+		legacyId = k.importLegacyPoll(ctx, *state.CurrentPoll)
+	}
+	pollId := func(id uint64) uint64 {
+		if id == 0 {
+			return legacyId
 		}
+		return id
 	}
 
-	key := make([]byte, len(types.KeyPollHistory)+8)
-	copy(key, types.KeyPollHistory)
-	for _, item := range state.PollHistory {
-		binary.BigEndian.PutUint64(key[len(types.KeyPollHistory):], uint64(item.Poll.EndTime.Unix()))
-		store.Set(key, k.cdc.MustMarshalBinaryBare(&item))
+	for _, w := range state.PollWeights {
+		k.setPollWeight(ctx, pollId(w.PollId), w.Acc, w.Weight)
+	}
+	for _, c := range state.PollCommitments {
+		prefix.NewStore(k.pollStore(ctx, pollId(c.PollId)), types.KeyPollCommitments).Set([]byte(c.Acc), c.Hash)
+	}
+	for _, ans := range state.PollAnswers {
+		poll, ok := k.GetPoll(ctx, pollId(ans.PollId))
+		if !ok {
+			panic(errors.Wrapf(types.ErrNoActivePoll, "poll #%d", ans.PollId))
+		}
+		if err := k.answer(ctx, poll, ans.Acc, ans.Ans, ans.Option); err != nil {
+			panic(err)
+		}
 	}
 }
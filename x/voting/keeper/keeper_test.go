@@ -16,6 +16,7 @@ import (
 
 	"github.com/arterynetwork/artr/app"
 	"github.com/arterynetwork/artr/util"
+	bank "github.com/arterynetwork/artr/x/bank/types"
 	"github.com/arterynetwork/artr/x/referral"
 	votingKeeper "github.com/arterynetwork/artr/x/voting/keeper"
 	"github.com/arterynetwork/artr/x/voting/types"
@@ -75,9 +76,9 @@ type Suite struct {
 func (s *Suite) SetupTest() { s.setupTest(nil, app.DefaultUser1ConsPubKey) }
 
 func (s *Suite) TestInitialState() {
-	_, ok := s.k.GetCurrentPoll(s.ctx)
+	_, ok := s.k.GetPoll(s.ctx, 1)
 	s.False(ok)
-	y, n := s.k.GetPollStatus(s.ctx)
+	y, n := s.k.GetPollStatus(s.ctx, 1)
 	s.EqualValues(0, y)
 	s.EqualValues(0, n)
 }
@@ -91,7 +92,7 @@ func (s *Suite) TestStartPoll_Validators() {
 	)
 	s.NoError(s.k.StartPoll(s.ctx, orig))
 
-	got, ok := s.k.GetCurrentPoll(s.ctx)
+	got, ok := s.k.GetPoll(s.ctx, 1)
 	s.True(ok)
 	s.Equal(orig.Author, got.Author)
 	s.Equal(orig.Name, got.Name)
@@ -103,7 +104,7 @@ func (s *Suite) TestStartPoll_Validators() {
 	s.NotNil(got.EndTime)
 	s.Equal(s.ctx.BlockTime().Add(18*time.Hour), *got.EndTime)
 
-	y, n := s.k.GetPollStatus(s.ctx)
+	y, n := s.k.GetPollStatus(s.ctx, 1)
 	s.EqualValues(0, y)
 	s.EqualValues(0, n)
 }
@@ -117,12 +118,12 @@ func (s *Suite) TestVotePoll_Validators() {
 	)
 	s.NoError(s.k.StartPoll(s.ctx, poll))
 
-	s.NoError(s.k.Answer(s.ctx, app.DefaultGenesisUsers["user1"].String(), true))
-	s.NoError(s.k.Answer(s.ctx, app.DefaultGenesisUsers["user2"].String(), false))
-	s.NoError(s.k.Answer(s.ctx, app.DefaultGenesisUsers["user3"].String(), true))
-	s.Error(s.k.Answer(s.ctx, app.DefaultGenesisUsers["user15"].String(), true))
+	s.NoError(s.k.Answer(s.ctx, 1, app.DefaultGenesisUsers["user1"].String(), true, 0))
+	s.NoError(s.k.Answer(s.ctx, 1, app.DefaultGenesisUsers["user2"].String(), false, 0))
+	s.NoError(s.k.Answer(s.ctx, 1, app.DefaultGenesisUsers["user3"].String(), true, 0))
+	s.Error(s.k.Answer(s.ctx, 1, app.DefaultGenesisUsers["user15"].String(), true, 0))
 
-	y, n := s.k.GetPollStatus(s.ctx)
+	y, n := s.k.GetPollStatus(s.ctx, 1)
 	s.EqualValues(2, y)
 	s.EqualValues(1, n)
 }
@@ -137,16 +138,16 @@ func (s *Suite) TestEndPoll_Positive() {
 	)
 	s.NoError(s.k.StartPoll(s.ctx, poll))
 
-	s.NoError(s.k.Answer(s.ctx, app.DefaultGenesisUsers["user1"].String(), true))
-	s.NoError(s.k.Answer(s.ctx, app.DefaultGenesisUsers["user2"].String(), false))
-	s.NoError(s.k.Answer(s.ctx, app.DefaultGenesisUsers["user3"].String(), true))
+	s.NoError(s.k.Answer(s.ctx, 1, app.DefaultGenesisUsers["user1"].String(), true, 0))
+	s.NoError(s.k.Answer(s.ctx, 1, app.DefaultGenesisUsers["user2"].String(), false, 0))
+	s.NoError(s.k.Answer(s.ctx, 1, app.DefaultGenesisUsers["user3"].String(), true, 0))
 
 	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(18 * time.Hour)).WithBlockHeight(s.ctx.BlockHeight() + 1)
 	s.nextBlock()
 
-	_, ok := s.k.GetCurrentPoll(s.ctx)
+	_, ok := s.k.GetPoll(s.ctx, 1)
 	s.False(ok)
-	y, n := s.k.GetPollStatus(s.ctx)
+	y, n := s.k.GetPollStatus(s.ctx, 1)
 	s.EqualValues(0, y)
 	s.EqualValues(0, n)
 
@@ -176,15 +177,15 @@ func (s *Suite) TestEndPoll_Negative() {
 	)
 	s.NoError(s.k.StartPoll(s.ctx, poll))
 
-	s.NoError(s.k.Answer(s.ctx, app.DefaultGenesisUsers["user1"].String(), true))
-	s.NoError(s.k.Answer(s.ctx, app.DefaultGenesisUsers["user2"].String(), false))
+	s.NoError(s.k.Answer(s.ctx, 1, app.DefaultGenesisUsers["user1"].String(), true, 0))
+	s.NoError(s.k.Answer(s.ctx, 1, app.DefaultGenesisUsers["user2"].String(), false, 0))
 
 	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(18 * time.Hour)).WithBlockHeight(s.ctx.BlockHeight() + 1)
 	s.nextBlock()
 
-	_, ok := s.k.GetCurrentPoll(s.ctx)
+	_, ok := s.k.GetPoll(s.ctx, 1)
 	s.False(ok)
-	y, n := s.k.GetPollStatus(s.ctx)
+	y, n := s.k.GetPollStatus(s.ctx, 1)
 	s.EqualValues(0, y)
 	s.EqualValues(0, n)
 
@@ -213,16 +214,16 @@ func (s *Suite) TestEndPoll_Undecided() {
 	}
 	s.NoError(s.k.StartPoll(s.ctx, poll))
 
-	s.NoError(s.k.Answer(s.ctx, app.DefaultGenesisUsers["user1"].String(), true))
-	s.NoError(s.k.Answer(s.ctx, app.DefaultGenesisUsers["user2"].String(), false))
-	s.NoError(s.k.Answer(s.ctx, app.DefaultGenesisUsers["user3"].String(), true))
+	s.NoError(s.k.Answer(s.ctx, 1, app.DefaultGenesisUsers["user1"].String(), true, 0))
+	s.NoError(s.k.Answer(s.ctx, 1, app.DefaultGenesisUsers["user2"].String(), false, 0))
+	s.NoError(s.k.Answer(s.ctx, 1, app.DefaultGenesisUsers["user3"].String(), true, 0))
 
 	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(18 * time.Hour)).WithBlockHeight(s.ctx.BlockHeight() + 1)
 	s.nextBlock()
 
-	_, ok := s.k.GetCurrentPoll(s.ctx)
+	_, ok := s.k.GetPoll(s.ctx, 1)
 	s.False(ok)
-	y, n := s.k.GetPollStatus(s.ctx)
+	y, n := s.k.GetPollStatus(s.ctx, 1)
 	s.EqualValues(0, y)
 	s.EqualValues(0, n)
 
@@ -241,6 +242,826 @@ func (s *Suite) TestEndPoll_Undecided() {
 	s.EqualValues(types.DECISION_UNSPECIFIED, history[0].Decision)
 }
 
+func (s *Suite) TestPoll_StakeWeightedMultiChoice() {
+	var (
+		user1 = app.DefaultGenesisUsers["user1"]
+		user2 = app.DefaultGenesisUsers["user2"]
+		user3 = app.DefaultGenesisUsers["user3"]
+	)
+	poll := types.NewPollValidators(user1, "the question", "When?", util.FractionZero())
+	poll.Quorum = nil
+	poll.StakeWeighted = true
+	poll.Options = []string{"today", "tomorrow", "never"}
+	s.NoError(poll.Validate())
+	s.NoError(s.k.StartPoll(s.ctx, poll))
+
+	saved := func() (accs []string) {
+		s.k.IteratePollWeights(s.ctx, 1, func(acc string, _ uint64) (stop bool) {
+			accs = append(accs, acc)
+			return false
+		})
+		return accs
+	}
+	s.Empty(saved(), "the stake must be saved lazily")
+
+	s.EqualValues(20_000_000000, s.k.GetPollWeight(s.ctx, 1, user1.String()))
+	s.NoError(s.app.GetDelegatingKeeper().Delegate(s.ctx, user1, sdk.NewInt(500_000000)))
+	s.EqualValues(20_000_000000, s.k.GetPollWeight(s.ctx, 1, user1.String()))
+	s.Contains(saved(), user1.String())
+	s.NoError(s.app.GetDelegatingKeeper().Delegate(s.ctx, user1, sdk.NewInt(500_000000)))
+	s.EqualValues(20_000_000000, s.k.GetPollWeight(s.ctx, 1, user1.String()))
+
+	s.NoError(s.k.Answer(s.ctx, 1, user1.String(), false, 1))
+	s.ErrorIs(s.k.Answer(s.ctx, 1, user1.String(), false, 2), types.ErrAlreadyVoted)
+	s.ErrorIs(s.k.Answer(s.ctx, 1, user2.String(), false, 4), types.ErrInvalidPollOption)
+	s.ErrorIs(s.k.Answer(s.ctx, 1, user2.String(), true, 0), types.ErrInvalidPollOption)
+	s.NoError(s.k.Answer(s.ctx, 1, user2.String(), false, 2))
+	s.NoError(s.k.Answer(s.ctx, 1, user3.String(), false, 2))
+	s.Equal([]uint64{20_000_000000, 40_000_000000, 0}, s.k.GetPollTallies(s.ctx, 1))
+
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(18 * time.Hour)).WithBlockHeight(s.ctx.BlockHeight() + 1)
+	s.nextBlock()
+
+	history := s.k.GetPollHistoryAll(s.ctx)
+	s.Equal(1, len(history))
+	s.Equal([]uint64{20_000_000000, 40_000_000000, 0}, history[0].Tallies)
+	s.Equal(types.DECISION_UNSPECIFIED, history[0].Decision)
+	s.Zero(s.k.GetPollWeight(s.ctx, 1, user1.String()))
+}
+
+func (s *Suite) TestPoll_Secret() {
+	var (
+		user1 = app.DefaultGenesisUsers["user1"].String()
+		user2 = app.DefaultGenesisUsers["user2"].String()
+		user3 = app.DefaultGenesisUsers["user3"].String()
+	)
+	poll := types.NewPollValidators(app.DefaultGenesisUsers["user1"], "the question", "Yes?", util.FractionZero())
+	poll.Quorum = nil
+	poll.Secret = true
+	s.NoError(poll.Validate())
+	s.NoError(s.k.StartPoll(s.ctx, poll))
+
+	current, _ := s.k.GetPoll(s.ctx, 1)
+	s.Equal(current.EndTime.Add(24*time.Hour), *current.RevealEndTime)
+
+	s.ErrorIs(s.k.Answer(s.ctx, 1, user1, true, 0), types.ErrPollMode)
+	s.NoError(s.k.CommitAnswer(s.ctx, 1, user1, types.PollCommitmentHash(user1, true, 0, "salt1")))
+	s.ErrorIs(s.k.CommitAnswer(s.ctx, 1, user1, types.PollCommitmentHash(user1, false, 0, "salt1")), types.ErrAlreadyVoted)
+	s.NoError(s.k.CommitAnswer(s.ctx, 1, user2, types.PollCommitmentHash(user2, false, 0, "salt2")))
+	s.NoError(s.k.CommitAnswer(s.ctx, 1, user3, types.PollCommitmentHash(user3, true, 0, "salt3")))
+	s.ErrorIs(s.k.RevealAnswer(s.ctx, 1, user1, true, 0, "salt1"), types.ErrPollPhase)
+
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(18 * time.Hour)).WithBlockHeight(s.ctx.BlockHeight() + 1)
+	s.nextBlock()
+
+	_, ok := s.k.GetPoll(s.ctx, 1)
+	s.True(ok)
+	s.ErrorIs(s.k.CommitAnswer(s.ctx, 1, user1, types.PollCommitmentHash(user1, true, 0, "salt")), types.ErrPollPhase)
+	s.ErrorIs(s.k.RevealAnswer(s.ctx, 1, user1, false, 0, "salt1"), types.ErrCommitmentMismatch)
+	s.ErrorIs(s.k.RevealAnswer(s.ctx, 1, user1, true, 0, "salt"), types.ErrCommitmentMismatch)
+	s.NoError(s.k.RevealAnswer(s.ctx, 1, user1, true, 0, "salt1"))
+	s.ErrorIs(s.k.RevealAnswer(s.ctx, 1, user1, true, 0, "salt1"), types.ErrAlreadyVoted)
+	s.NoError(s.k.RevealAnswer(s.ctx, 1, user2, false, 0, "salt2"))
+	yes, no := s.k.GetPollStatus(s.ctx, 1)
+	s.EqualValues(1, yes)
+	s.EqualValues(1, no)
+
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(24 * time.Hour)).WithBlockHeight(s.ctx.BlockHeight() + 1)
+	s.nextBlock()
+
+	_, ok = s.k.GetPoll(s.ctx, 1)
+	s.False(ok)
+	history := s.k.GetPollHistoryAll(s.ctx)
+	s.Equal(1, len(history))
+	s.EqualValues(1, history[0].Yes)
+	s.EqualValues(1, history[0].No)
+	s.Nil(s.k.GetPollCommitment(s.ctx, 1, user3))
+}
+
+func (s *Suite) TestParallelPolls() {
+	var (
+		user1 = app.DefaultGenesisUsers["user1"]
+		user2 = app.DefaultGenesisUsers["user2"]
+	)
+	long := types.NewPollValidators(user1, "long", "Long?", util.FractionZero())
+	short := types.NewPollValidators(user2, "short", "Short?", util.FractionZero())
+	short.Period = 5
+	tooLong := types.NewPollValidators(user2, "too long", "Too long?", util.FractionZero())
+	tooLong.Period = 24*30 + 1
+
+	s.NoError(s.k.StartPoll(s.ctx, long))
+	s.NoError(s.k.StartPoll(s.ctx, short))
+	s.ErrorIs(s.k.StartPoll(s.ctx, tooLong), types.ErrPollPeriod)
+
+	active := s.k.GetActivePolls(s.ctx)
+	s.Equal(2, len(active))
+	s.EqualValues(1, active[0].Id)
+	s.EqualValues(2, active[1].Id)
+	s.Equal(active[1].StartTime.Add(5*time.Hour), *active[1].EndTime)
+
+	s.NoError(s.k.Answer(s.ctx, 1, user1.String(), true, 0))
+	s.NoError(s.k.Answer(s.ctx, 2, user1.String(), false, 0))
+	s.NoError(s.k.Answer(s.ctx, 2, user2.String(), true, 0))
+	s.ErrorIs(s.k.Answer(s.ctx, 3, user1.String(), true, 0), types.ErrNoActivePoll)
+
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(5 * time.Hour)).WithBlockHeight(s.ctx.BlockHeight() + 1)
+	s.nextBlock()
+
+	active = s.k.GetActivePolls(s.ctx)
+	s.Equal(1, len(active))
+	s.EqualValues(1, active[0].Id)
+	item, ok := s.k.GetPollHistoryItem(s.ctx, 2)
+	s.True(ok)
+	s.EqualValues(1, item.Yes)
+	s.EqualValues(1, item.No)
+	y, n := s.k.GetPollStatus(s.ctx, 1)
+	s.EqualValues(1, y)
+	s.EqualValues(0, n)
+}
+
+func (s *Suite) TestCancelPoll() {
+	var (
+		user1 = app.DefaultGenesisUsers["user1"]
+		user2 = app.DefaultGenesisUsers["user2"]
+		user3 = app.DefaultGenesisUsers["user3"]
+		user4 = app.DefaultGenesisUsers["user4"]
+	)
+	s.NoError(s.k.StartPoll(s.ctx, types.NewPollValidators(user1, "first", "First?", util.FractionZero())))
+	s.NoError(s.k.StartPoll(s.ctx, types.NewPollValidators(user1, "second", "Second?", util.FractionZero())))
+	s.NoError(s.k.Answer(s.ctx, 1, user2.String(), true, 0))
+
+	s.ErrorIs(s.k.CancelPoll(s.ctx, 1, user4.String()), types.ErrSignerNotAllowed)
+	s.NoError(s.k.CancelPoll(s.ctx, 1, user2.String()))
+	s.ErrorIs(s.k.CancelPoll(s.ctx, 1, user2.String()), types.ErrAlreadyVoted)
+	poll, ok := s.k.GetPoll(s.ctx, 1)
+	s.True(ok)
+	s.Equal([]string{user2.String()}, poll.CancelVotes)
+
+	s.NoError(s.k.CancelPoll(s.ctx, 1, user3.String()))
+	_, ok = s.k.GetPoll(s.ctx, 1)
+	s.False(ok)
+	s.ErrorIs(s.k.Answer(s.ctx, 1, user3.String(), true, 0), types.ErrNoActivePoll)
+	s.ErrorIs(s.k.CancelPoll(s.ctx, 1, user1.String()), types.ErrNoActivePoll)
+
+	s.NoError(s.k.CancelPoll(s.ctx, 2, user1.String()))
+	s.Empty(s.k.GetActivePolls(s.ctx))
+
+	history := s.k.GetPollHistoryAll(s.ctx)
+	s.Equal(2, len(history))
+	for i, item := range history {
+		s.EqualValues(i+1, item.Poll.Id)
+		s.True(item.Cancelled)
+		s.Zero(item.Yes)
+	}
+	y, _ := s.k.GetPollStatus(s.ctx, 1)
+	s.Zero(y)
+
+	// The ending tasks are removed along with the polls.
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(18 * time.Hour)).WithBlockHeight(s.ctx.BlockHeight() + 1)
+	s.nextBlock()
+	s.Equal(2, len(s.k.GetPollHistoryAll(s.ctx)))
+}
+
+func (s *Suite) TestParallelProposals() {
+	var (
+		user1 = app.DefaultGenesisUsers["user1"]
+		user2 = app.DefaultGenesisUsers["user2"]
+		user3 = app.DefaultGenesisUsers["user3"]
+	)
+	minSend := func(author sdk.AccAddress, amount int64) types.MsgPropose {
+		return types.MsgPropose{Proposal: types.Proposal{
+			Name:   "min send",
+			Author: author.String(),
+			Type:   types.PROPOSAL_TYPE_MIN_SEND,
+			Args:   &types.Proposal_MinAmount{MinAmount: &types.MinAmountArgs{MinAmount: amount}},
+		}}
+	}
+
+	s.NoError(s.k.Propose(s.ctx, minSend(user1, 1000)))
+	s.NoError(s.k.Propose(s.ctx, types.MsgPropose{Proposal: types.Proposal{
+		Name:   "max validators",
+		Author: user2.String(),
+		Type:   types.PROPOSAL_TYPE_MAX_VALIDATORS,
+		Args:   &types.Proposal_Count{Count: &types.CountArgs{Count: 42}},
+	}}))
+	s.ErrorIs(s.k.Propose(s.ctx, minSend(user3, 2000)), types.ErrOtherActive)
+
+	active := s.k.GetActiveProposals(s.ctx, 0, 0)
+	s.Equal(2, len(active))
+	s.EqualValues(1, active[0].Proposal.Id)
+	s.EqualValues(2, active[1].Proposal.Id)
+	s.Equal(1, len(s.k.GetActiveProposals(s.ctx, 1, 2)))
+
+	s.NoError(s.k.Vote(s.ctx, user1, 2, types.VOTE_OPTION_YES))
+	s.ErrorIs(s.k.Vote(s.ctx, user1, 2, types.VOTE_OPTION_YES), types.ErrAlreadyVoted)
+	s.NoError(s.k.Vote(s.ctx, user3, 2, types.VOTE_OPTION_YES))
+	s.ErrorIs(s.k.Vote(s.ctx, user3, 2, types.VOTE_OPTION_YES), types.ErrNoActiveProposals)
+	s.EqualValues(42, s.app.GetNodingKeeper().GetParams(s.ctx).MaxValidators)
+
+	active = s.k.GetActiveProposals(s.ctx, 0, 0)
+	s.Equal(1, len(active))
+	s.EqualValues(1, active[0].Proposal.Id)
+
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(18 * time.Hour)).WithBlockHeight(s.ctx.BlockHeight() + 1)
+	s.nextBlock()
+
+	s.Empty(s.k.GetActiveProposals(s.ctx, 0, 0))
+	s.Equal(2, len(s.k.GetHistory(s.ctx, 10, 1)))
+	s.NoError(s.k.Propose(s.ctx, minSend(user3, 2000)))
+	s.EqualValues(4, s.k.GetNextProposalId(s.ctx))
+}
+
+func (s *Suite) TestThresholdAndQuorum() {
+	var (
+		user1 = app.DefaultGenesisUsers["user1"]
+		user2 = app.DefaultGenesisUsers["user2"]
+		user3 = app.DefaultGenesisUsers["user3"]
+		user4 = app.DefaultGenesisUsers["user4"]
+	)
+	s.k.AddGovernor(s.ctx, user4)
+	propose := func(typ types.ProposalType, amount int64) uint64 {
+		id := s.k.GetNextProposalId(s.ctx)
+		s.NoError(s.k.Propose(s.ctx, types.MsgPropose{Proposal: types.Proposal{
+			Name:   "proposal",
+			Author: user1.String(),
+			Type:   typ,
+			Args:   &types.Proposal_MinAmount{MinAmount: &types.MinAmountArgs{MinAmount: amount}},
+		}}))
+		return id
+	}
+	isActive := func(id uint64) bool {
+		_, ok := s.k.GetActiveProposal(s.ctx, id)
+		return ok
+	}
+
+	// 2/3 of all four governors cannot be reached anymore
+	id := propose(types.PROPOSAL_TYPE_MIN_SEND, 1000)
+	s.NoError(s.k.Vote(s.ctx, user2, id, types.VOTE_OPTION_NO))
+	s.True(isActive(id))
+	s.NoError(s.k.Vote(s.ctx, user3, id, types.VOTE_OPTION_NO))
+	s.False(isActive(id))
+	s.Equal(types.EXECUTION_STATUS_REJECTED, s.k.GetHistory(s.ctx, 10, 1)[0].Status)
+
+	params := s.k.GetParams(s.ctx)
+	params.Quorum = util.Percent(50)
+	params.VetoShare = util.FractionInt(1)
+	params.CategoryThresholds = []types.CategoryThreshold{
+		{Category: types.PROPOSAL_CATEGORY_ECONOMY, Threshold: util.Percent(50)},
+	}
+	s.k.SetParams(s.ctx, params)
+
+	// The economy category needs a half of all governors only
+	id = propose(types.PROPOSAL_TYPE_MIN_DELEGATE, 1000)
+	s.NoError(s.k.Vote(s.ctx, user2, id, types.VOTE_OPTION_YES))
+	s.False(isActive(id))
+	s.EqualValues(1000, s.app.GetDelegatingKeeper().GetParams(s.ctx).MinDelegate)
+
+	// The general threshold is applied to the votes cast by the end of the voting period
+	params.CategoryThresholds = []types.CategoryThreshold{}
+	s.k.SetParams(s.ctx, params)
+	id = propose(types.PROPOSAL_TYPE_DUST_DELEGATION, 2000)
+	s.NoError(s.k.Vote(s.ctx, user2, id, types.VOTE_OPTION_YES))
+	s.True(isActive(id))
+	s.NoError(s.k.Vote(s.ctx, user3, id, types.VOTE_OPTION_NO))
+	s.True(isActive(id))
+
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(18 * time.Hour)).WithBlockHeight(s.ctx.BlockHeight() + 1)
+	s.nextBlock()
+	s.False(isActive(id))
+	s.Equal(types.EXECUTION_STATUS_SUCCEEDED, s.k.GetHistory(s.ctx, 10, 1)[2].Status)
+}
+
+func (s *Suite) TestChangeVote() {
+	var (
+		user1 = app.DefaultGenesisUsers["user1"]
+		user2 = app.DefaultGenesisUsers["user2"]
+		user3 = app.DefaultGenesisUsers["user3"]
+		user4 = app.DefaultGenesisUsers["user4"]
+	)
+	s.k.AddGovernor(s.ctx, user4)
+
+	s.NoError(s.k.Propose(s.ctx, types.MsgPropose{Proposal: types.Proposal{
+		Name:   "min send",
+		Author: user1.String(),
+		Type:   types.PROPOSAL_TYPE_MIN_SEND,
+		Args:   &types.Proposal_MinAmount{MinAmount: &types.MinAmountArgs{MinAmount: 1000}},
+	}}))
+	s.ErrorIs(s.k.ChangeVote(s.ctx, user2, 1, types.VOTE_OPTION_YES), types.ErrNotVoted)
+	s.NoError(s.k.Vote(s.ctx, user2, 1, types.VOTE_OPTION_NO))
+	s.ErrorIs(s.k.ChangeVote(s.ctx, user2, 1, types.VOTE_OPTION_NO), types.ErrAlreadyVoted)
+	s.ErrorIs(s.k.ChangeVote(s.ctx, app.DefaultGenesisUsers["user5"], 1, types.VOTE_OPTION_YES), types.ErrSignerNotAllowed)
+
+	s.NoError(s.k.ChangeVote(s.ctx, user2, 1, types.VOTE_OPTION_YES))
+	ap, ok := s.k.GetActiveProposal(s.ctx, 1)
+	s.True(ok)
+	s.Equal([]string{user1.String(), user2.String()}, ap.Agreed)
+	s.Empty(ap.Disagreed)
+
+	var found bool
+	for _, ev := range s.ctx.EventManager().Events() {
+		if ev.Type == "proposal_vote_changed" {
+			found = true
+		}
+	}
+	s.True(found)
+
+	// Once user2 changes their mind back, 2/3 of the government cannot be reached anymore
+	s.NoError(s.k.Vote(s.ctx, user3, 1, types.VOTE_OPTION_NO))
+	_, ok = s.k.GetActiveProposal(s.ctx, 1)
+	s.True(ok)
+	s.NoError(s.k.ChangeVote(s.ctx, user2, 1, types.VOTE_OPTION_NO))
+	_, ok = s.k.GetActiveProposal(s.ctx, 1)
+	s.False(ok)
+	s.EqualValues(types.EXECUTION_STATUS_REJECTED, s.k.GetHistory(s.ctx, 10, 1)[0].Status)
+	s.ErrorIs(s.k.ChangeVote(s.ctx, user2, 1, types.VOTE_OPTION_YES), types.ErrNoActiveProposals)
+	s.ErrorIs(s.k.Vote(s.ctx, user4, 1, types.VOTE_OPTION_YES), types.ErrNoActiveProposals)
+}
+
+func (s *Suite) TestAbstainAndVeto() {
+	var (
+		user1 = app.DefaultGenesisUsers["user1"]
+		user2 = app.DefaultGenesisUsers["user2"]
+		user3 = app.DefaultGenesisUsers["user3"]
+		user4 = app.DefaultGenesisUsers["user4"]
+	)
+	s.k.AddGovernor(s.ctx, user4)
+	minSend := func(amount int64) types.MsgPropose {
+		return types.MsgPropose{Proposal: types.Proposal{
+			Name:   "min send",
+			Author: user1.String(),
+			Type:   types.PROPOSAL_TYPE_MIN_SEND,
+			Args:   &types.Proposal_MinAmount{MinAmount: &types.MinAmountArgs{MinAmount: amount}},
+		}}
+	}
+
+	// An abstention doesn't count toward the threshold: 2 of 3 is enough
+	s.NoError(s.k.Propose(s.ctx, minSend(1000)))
+	s.NoError(s.k.Vote(s.ctx, user2, 1, types.VOTE_OPTION_YES))
+	s.NoError(s.k.Vote(s.ctx, user3, 1, types.VOTE_OPTION_ABSTAIN))
+	ap, ok := s.k.GetActiveProposal(s.ctx, 1)
+	s.True(ok)
+	s.Equal(types.Tally{Yes: 2, Abstain: 1}, ap.Tally())
+	s.Equal(types.VOTE_OPTION_ABSTAIN, ap.VoteOf(user3))
+	s.ErrorIs(s.k.Vote(s.ctx, user3, 1, types.VOTE_OPTION_YES), types.ErrAlreadyVoted)
+	s.NoError(s.k.Vote(s.ctx, user4, 1, types.VOTE_OPTION_NO))
+	s.EqualValues(1000, s.app.GetBankKeeper().GetParams(s.ctx).MinSend)
+
+	record := s.k.GetHistory(s.ctx, 10, 1)[0]
+	s.Equal(types.EXECUTION_STATUS_SUCCEEDED, record.Status)
+	s.Equal([]string{user3.String()}, record.Abstained)
+	s.Equal([]string{user4.String()}, record.Disagreed)
+	s.False(record.RejectedByVeto)
+
+	// Two vetoes of four exceed 1/3 whatever the last governor decides
+	s.NoError(s.k.Propose(s.ctx, minSend(2000)))
+	s.NoError(s.k.Vote(s.ctx, user2, 2, types.VOTE_OPTION_VETO))
+	_, ok = s.k.GetActiveProposal(s.ctx, 2)
+	s.True(ok)
+	s.NoError(s.k.Vote(s.ctx, user3, 2, types.VOTE_OPTION_VETO))
+	_, ok = s.k.GetActiveProposal(s.ctx, 2)
+	s.False(ok)
+	s.EqualValues(1000, s.app.GetBankKeeper().GetParams(s.ctx).MinSend)
+
+	record = s.k.GetHistory(s.ctx, 10, 1)[1]
+	s.Equal(types.EXECUTION_STATUS_REJECTED, record.Status)
+	s.Equal([]string{user2.String(), user3.String()}, record.Vetoed)
+	s.Empty(record.Disagreed)
+	s.True(record.RejectedByVeto)
+}
+
+func (s *Suite) TestMultiChange() {
+	var (
+		user1 = app.DefaultGenesisUsers["user1"]
+		user2 = app.DefaultGenesisUsers["user2"]
+		user3 = app.DefaultGenesisUsers["user3"]
+	)
+	multiChange := func(changes ...types.Proposal) types.MsgPropose {
+		return types.MsgPropose{Proposal: types.Proposal{
+			Name:   "multi-change",
+			Author: user1.String(),
+			Type:   types.PROPOSAL_TYPE_MULTI_CHANGE,
+			Args:   &types.Proposal_MultiChange{MultiChange: &types.MultiChangeArgs{Changes: changes}},
+		}}
+	}
+	accept := func(msg types.MsgPropose) {
+		s.NoError(msg.ValidateBasic())
+		s.NoError(s.k.Propose(s.ctx, msg))
+		id := s.k.GetNextProposalId(s.ctx) - 1
+		s.NoError(s.k.Vote(s.ctx, user2, id, types.VOTE_OPTION_YES))
+		s.NoError(s.k.Vote(s.ctx, user3, id, types.VOTE_OPTION_YES))
+	}
+
+	s.Error(multiChange(
+		types.Proposal{Type: types.PROPOSAL_TYPE_MIN_SEND, Args: &types.Proposal_MinAmount{MinAmount: &types.MinAmountArgs{MinAmount: 1}}},
+		types.Proposal{Type: types.PROPOSAL_TYPE_MIN_SEND, Args: &types.Proposal_MinAmount{MinAmount: &types.MinAmountArgs{MinAmount: 2}}},
+	).ValidateBasic())
+
+	accept(multiChange(
+		types.Proposal{Type: types.PROPOSAL_TYPE_TRANSACTION_FEE, Args: &types.Proposal_Portion{Portion: &types.PortionArgs{Fraction: util.NewFraction(3, 1000)}}},
+		types.Proposal{Type: types.PROPOSAL_TYPE_TRANSACTION_FEE_SPLIT_RATIOS, Args: &types.Proposal_Portions{Portions: &types.PortionsArgs{Fractions: []util.Fraction{util.NewFraction(1, 2), util.NewFraction(1, 4)}}}},
+	))
+	bp := s.app.GetBankKeeper().GetParams(s.ctx)
+	s.Equal(util.NewFraction(3, 1000).String(), bp.TransactionFee.String())
+	s.Equal(util.NewFraction(1, 2).String(), bp.TransactionFeeSplitRatios.ForProposer.String())
+	s.Equal(util.NewFraction(1, 4).String(), bp.TransactionFeeSplitRatios.ForCompany.String())
+
+	maxValidators := s.app.GetNodingKeeper().GetParams(s.ctx).MaxValidators
+	s.ErrorIs(s.k.Propose(s.ctx, multiChange(
+		types.Proposal{Type: types.PROPOSAL_TYPE_MAX_VALIDATORS, Args: &types.Proposal_Count{Count: &types.CountArgs{Count: maxValidators + 1}}},
+		types.Proposal{Type: types.PROPOSAL_TYPE_VALIDATOR_BONUS, Args: &types.Proposal_Portion{Portion: &types.PortionArgs{Fraction: util.Percent(1)}}},
+	)), types.ErrProposalCannotBeApplied)
+	s.Equal(maxValidators, s.app.GetNodingKeeper().GetParams(s.ctx).MaxValidators)
+	s.Empty(s.k.GetActiveProposals(s.ctx, 0, 0))
+}
+
+func (s *Suite) TestProposalDeposit() {
+	var (
+		user1 = app.DefaultGenesisUsers["user1"]
+		user2 = app.DefaultGenesisUsers["user2"]
+		user3 = app.DefaultGenesisUsers["user3"]
+	)
+	params := s.k.GetParams(s.ctx)
+	params.ProposalDeposit = 1_000000
+	s.k.SetParams(s.ctx, params)
+
+	bk := s.app.GetBankKeeper()
+	balance := func() int64 { return bk.GetBalance(s.ctx, user1).AmountOf(util.ConfigMainDenom).Int64() }
+	supply := func() int64 { return bk.GetSupply(s.ctx).GetTotal().AmountOf(util.ConfigMainDenom).Int64() }
+	minSend := func(amount int64) types.MsgPropose {
+		return types.MsgPropose{Proposal: types.Proposal{
+			Name:   "min send",
+			Author: user1.String(),
+			Type:   types.PROPOSAL_TYPE_MIN_SEND,
+			Args:   &types.Proposal_MinAmount{MinAmount: &types.MinAmountArgs{MinAmount: amount}},
+		}}
+	}
+	initBalance, initSupply := balance(), supply()
+
+	// Passed: the deposit is returned
+	s.NoError(s.k.Propose(s.ctx, minSend(1000)))
+	s.Equal(initBalance-1_000000, balance())
+	ap, _ := s.k.GetActiveProposal(s.ctx, 1)
+	s.EqualValues(1_000000, ap.Deposit)
+	s.NoError(s.k.Vote(s.ctx, user2, 1, types.VOTE_OPTION_YES))
+	s.NoError(s.k.Vote(s.ctx, user3, 1, types.VOTE_OPTION_YES))
+	s.Equal(initBalance, balance())
+	s.EqualValues(1_000000, s.k.GetHistory(s.ctx, 10, 1)[0].Deposit)
+
+	// Rejected: the deposit is returned
+	s.NoError(s.k.Propose(s.ctx, minSend(2000)))
+	s.NoError(s.k.Vote(s.ctx, user2, 2, types.VOTE_OPTION_NO))
+	s.NoError(s.k.Vote(s.ctx, user3, 2, types.VOTE_OPTION_NO))
+	s.Equal(initBalance, balance())
+
+	// Vetoed: the deposit is burned
+	s.NoError(s.k.Propose(s.ctx, minSend(3000)))
+	s.NoError(s.k.Vote(s.ctx, user2, 3, types.VOTE_OPTION_VETO))
+	s.NoError(s.k.Vote(s.ctx, user3, 3, types.VOTE_OPTION_VETO))
+	s.True(s.k.GetHistory(s.ctx, 10, 1)[2].RejectedByVeto)
+	s.Equal(initBalance-1_000000, balance())
+	s.Equal(initSupply-1_000000, supply())
+
+	// Not enough money
+	params.ProposalDeposit = balance() + 1
+	s.k.SetParams(s.ctx, params)
+	s.Error(s.k.Propose(s.ctx, minSend(4000)))
+	s.Empty(s.k.GetActiveProposals(s.ctx, 0, 0))
+}
+
+func (s *Suite) TestActivateAt() {
+	var (
+		user1 = app.DefaultGenesisUsers["user1"]
+		user2 = app.DefaultGenesisUsers["user2"]
+		user3 = app.DefaultGenesisUsers["user3"]
+	)
+	minSend := func(amount int64, activateAt time.Time) types.MsgPropose {
+		return types.MsgPropose{Proposal: types.Proposal{
+			Name:       "min send",
+			Author:     user1.String(),
+			Type:       types.PROPOSAL_TYPE_MIN_SEND,
+			Args:       &types.Proposal_MinAmount{MinAmount: &types.MinAmountArgs{MinAmount: amount}},
+			ActivateAt: &activateAt,
+		}}
+	}
+	bk := s.app.GetBankKeeper()
+	initMinSend := bk.GetParams(s.ctx).MinSend
+	activateAt := s.ctx.BlockTime().Add(48 * time.Hour)
+
+	s.ErrorIs(s.k.Propose(s.ctx, minSend(1000, s.ctx.BlockTime())), types.ErrProposalCannotBeApplied)
+
+	s.NoError(s.k.Propose(s.ctx, minSend(1000, activateAt)))
+	s.NoError(s.k.Vote(s.ctx, user2, 1, types.VOTE_OPTION_YES))
+	s.NoError(s.k.Vote(s.ctx, user3, 1, types.VOTE_OPTION_YES))
+	s.Equal(initMinSend, bk.GetParams(s.ctx).MinSend)
+	s.Equal(types.EXECUTION_STATUS_SCHEDULED, s.k.GetHistory(s.ctx, 10, 1)[0].Status)
+	pending := s.k.GetPendingChanges(s.ctx)
+	s.Equal(1, len(pending))
+	s.EqualValues(1, pending[0].Proposal.Id)
+
+	// A pending change blocks proposals changing the same parameter
+	s.ErrorIs(s.k.Propose(s.ctx, minSend(2000, activateAt)), types.ErrOtherActive)
+
+	s.ctx = s.ctx.WithBlockTime(activateAt).WithBlockHeight(s.ctx.BlockHeight() + 1)
+	s.nextBlock()
+
+	s.EqualValues(1000, bk.GetParams(s.ctx).MinSend)
+	s.Empty(s.k.GetPendingChanges(s.ctx))
+	history := s.k.GetHistory(s.ctx, 10, 1)
+	s.Equal(1, len(history))
+	s.Equal(types.EXECUTION_STATUS_SUCCEEDED, history[0].Status)
+}
+
+func (s *Suite) TestParamProposals() {
+	var (
+		user1 = app.DefaultGenesisUsers["user1"]
+		user2 = app.DefaultGenesisUsers["user2"]
+		user3 = app.DefaultGenesisUsers["user3"]
+		user4 = app.DefaultGenesisUsers["user4"]
+		user5 = app.DefaultGenesisUsers["user5"]
+	)
+	propose := func(p types.Proposal) error {
+		p.Name, p.Author = "param", user1.String()
+		msg := types.MsgPropose{Proposal: p}
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+		return s.k.Propose(s.ctx, msg)
+	}
+	accept := func(p types.Proposal) {
+		s.NoError(propose(p))
+		id := s.k.GetNextProposalId(s.ctx) - 1
+		s.NoError(s.k.Vote(s.ctx, user2, id, types.VOTE_OPTION_YES))
+		s.NoError(s.k.Vote(s.ctx, user3, id, types.VOTE_OPTION_YES))
+	}
+	count := func(typ types.ProposalType, n uint32) types.Proposal {
+		return types.Proposal{Type: typ, Args: &types.Proposal_Count{Count: &types.CountArgs{Count: n}}}
+	}
+
+	s.Error(propose(count(types.PROPOSAL_TYPE_UNJAIL_AFTER, 0)))
+	s.Error(propose(count(types.PROPOSAL_TYPE_BASE_VPN_GB, 0)))
+	s.Error(propose(count(types.PROPOSAL_TYPE_BASE_STORAGE_GB, 0)))
+	s.Error(propose(count(types.PROPOSAL_TYPE_VOTING_PERIOD, 1<<31)))
+	s.Error(propose(types.Proposal{Type: types.PROPOSAL_TYPE_RENAME_PRICE, Args: &types.Proposal_MinAmount{MinAmount: &types.MinAmountArgs{MinAmount: -1}}}))
+	s.Error(propose(types.Proposal{Type: types.PROPOSAL_TYPE_COMPANY_ACCOUNT, Args: &types.Proposal_Address{Address: &types.AddressArgs{Address: "nonsense"}}}))
+	s.Error(propose(types.Proposal{Type: types.PROPOSAL_TYPE_COMPANY_ACCOUNTS, Args: &types.Proposal_CompanyAccounts{CompanyAccounts: &types.CompanyAccountsArgs{}}}))
+	s.Empty(s.k.GetActiveProposals(s.ctx, 0, 0))
+
+	accept(count(types.PROPOSAL_TYPE_UNJAIL_AFTER, 100))
+	accept(types.Proposal{Type: types.PROPOSAL_TYPE_RENAME_PRICE, Args: &types.Proposal_MinAmount{MinAmount: &types.MinAmountArgs{MinAmount: 5_000000}}})
+	accept(count(types.PROPOSAL_TYPE_BASE_VPN_GB, 10))
+	accept(count(types.PROPOSAL_TYPE_BASE_STORAGE_GB, 20))
+	accept(count(types.PROPOSAL_TYPE_VOTING_PERIOD, 48))
+	accept(count(types.PROPOSAL_TYPE_POLL_PERIOD, 72))
+	accept(types.Proposal{Type: types.PROPOSAL_TYPE_COMPANY_ACCOUNTS, Args: &types.Proposal_CompanyAccounts{CompanyAccounts: &types.CompanyAccountsArgs{
+		CompanyAccounts: &referral.CompanyAccounts{ForSubscription: user4.String()},
+	}}})
+	accept(types.Proposal{Type: types.PROPOSAL_TYPE_COMPANY_ACCOUNT, Args: &types.Proposal_Address{Address: &types.AddressArgs{Address: user5.String()}}})
+	s.Empty(s.k.GetActiveProposals(s.ctx, 0, 0))
+
+	for _, item := range s.k.GetHistory(s.ctx, 100, 1) {
+		s.Equal(types.EXECUTION_STATUS_SUCCEEDED, item.Status, item.Proposal.Type.String())
+	}
+	s.EqualValues(100, s.app.GetNodingKeeper().GetParams(s.ctx).UnjailAfter)
+	pp := s.app.GetProfileKeeper().GetParams(s.ctx)
+	s.EqualValues(5_000000, pp.RenamePrice)
+	s.EqualValues(10, pp.BaseVpnGb)
+	s.EqualValues(20, pp.BaseStorageGb)
+	vp := s.k.GetParams(s.ctx)
+	s.EqualValues(48, vp.VotingPeriod)
+	s.EqualValues(72, vp.PollPeriod)
+	s.Equal(user4.String(), s.app.GetReferralKeeper().GetParams(s.ctx).CompanyAccounts.ForSubscription)
+	s.Equal(user5.String(), s.app.GetBankKeeper().GetParams(s.ctx).CompanyAccount)
+}
+
+func (s *Suite) TestParamChange() {
+	var (
+		user1 = app.DefaultGenesisUsers["user1"]
+		user2 = app.DefaultGenesisUsers["user2"]
+		user3 = app.DefaultGenesisUsers["user3"]
+	)
+	paramChange := func(changes ...types.ParamChange) types.MsgPropose {
+		return types.MsgPropose{Proposal: types.Proposal{
+			Name:   "param change",
+			Author: user1.String(),
+			Type:   types.PROPOSAL_TYPE_PARAM_CHANGE,
+			Args:   &types.Proposal_ParamChange{ParamChange: &types.ParamChangeArgs{Changes: changes}},
+		}}
+	}
+
+	s.Error(paramChange().ValidateBasic())
+	s.Error(paramChange(types.ParamChange{Subspace: "noding", Key: "JailAfter", Value: "{"}).ValidateBasic())
+	s.Error(paramChange(
+		types.ParamChange{Subspace: "noding", Key: "JailAfter", Value: "20"},
+		types.ParamChange{Subspace: "noding", Key: "JailAfter", Value: "30"},
+	).ValidateBasic())
+
+	for _, change := range []types.ParamChange{
+		{Subspace: "nonsense", Key: "JailAfter", Value: "20"},
+		{Subspace: "noding", Key: "Nonsense", Value: "20"},
+		{Subspace: "noding", Key: "UnjailAfter", Value: "0"},
+		{Subspace: "noding", Key: "JailAfter", Value: `"twenty"`},
+	} {
+		msg := paramChange(change)
+		s.NoError(msg.ValidateBasic())
+		s.ErrorIs(s.k.Propose(s.ctx, msg), types.ErrProposalCannotBeApplied, change.Target())
+	}
+	s.Empty(s.k.GetActiveProposals(s.ctx, 0, 0))
+
+	msg := paramChange(
+		types.ParamChange{Subspace: "noding", Key: "JailAfter", Value: "20"},
+		types.ParamChange{Subspace: "profile", Key: "RenamePrice", Value: `"5000000"`},
+		types.ParamChange{Subspace: "voting", Key: "PollPeriod", Value: "36"},
+	)
+	s.NoError(msg.ValidateBasic())
+	s.NoError(s.k.Propose(s.ctx, msg))
+	s.ErrorIs(s.k.Propose(s.ctx, types.MsgPropose{Proposal: types.Proposal{
+		Name:   "jail after",
+		Author: user1.String(),
+		Type:   types.PROPOSAL_TYPE_JAIL_AFTER,
+		Args:   &types.Proposal_Count{Count: &types.CountArgs{Count: 30}},
+	}}), types.ErrOtherActive)
+	s.NoError(s.k.Vote(s.ctx, user2, 1, types.VOTE_OPTION_YES))
+	s.NoError(s.k.Vote(s.ctx, user3, 1, types.VOTE_OPTION_YES))
+
+	s.Equal(types.EXECUTION_STATUS_SUCCEEDED, s.k.GetHistory(s.ctx, 10, 1)[0].Status)
+	s.EqualValues(20, s.app.GetNodingKeeper().GetParams(s.ctx).JailAfter)
+	s.EqualValues(5000000, s.app.GetProfileKeeper().GetParams(s.ctx).RenamePrice)
+	s.EqualValues(36, s.k.GetParams(s.ctx).PollPeriod)
+}
+
+func (s *Suite) TestParamChange_Immutable() {
+	user1 := app.DefaultGenesisUsers["user1"]
+	magic := s.app.GetProfileKeeper().GetParams(s.ctx).CardMagic
+
+	msg := types.MsgPropose{Proposal: types.Proposal{
+		Name:   "card magic",
+		Author: user1.String(),
+		Type:   types.PROPOSAL_TYPE_PARAM_CHANGE,
+		Args: &types.Proposal_ParamChange{ParamChange: &types.ParamChangeArgs{Changes: []types.ParamChange{
+			{Subspace: "profile", Key: "CardMagic", Value: `"1"`},
+		}}},
+	}}
+	s.Error(msg.ValidateBasic())
+	s.ErrorIs(s.k.Propose(s.ctx, msg), types.ErrProposalCannotBeApplied)
+	s.Empty(s.k.GetActiveProposals(s.ctx, 0, 0))
+	s.Equal(magic, s.app.GetProfileKeeper().GetParams(s.ctx).CardMagic)
+
+	// Rules involving several parameters are checked as well
+	msg.Proposal.Args = &types.Proposal_ParamChange{ParamChange: &types.ParamChangeArgs{Changes: []types.ParamChange{
+		{Subspace: "noding", Key: "MaxMissedBlocksPerWindow", Value: "10"},
+	}}}
+	s.NoError(msg.ValidateBasic())
+	s.ErrorIs(s.k.Propose(s.ctx, msg), types.ErrProposalCannotBeApplied)
+}
+
+func (s *Suite) TestParamChange_Conflicts() {
+	var (
+		user1 = app.DefaultGenesisUsers["user1"]
+		user2 = app.DefaultGenesisUsers["user2"]
+	)
+	paramChange := func(changes ...types.ParamChange) types.MsgPropose {
+		return types.MsgPropose{Proposal: types.Proposal{
+			Name:   "param change",
+			Author: user1.String(),
+			Type:   types.PROPOSAL_TYPE_PARAM_CHANGE,
+			Args:   &types.Proposal_ParamChange{ParamChange: &types.ParamChangeArgs{Changes: changes}},
+		}}
+	}
+	minSend := types.MsgPropose{Proposal: types.Proposal{
+		Name:   "min send",
+		Author: user1.String(),
+		Type:   types.PROPOSAL_TYPE_MIN_SEND,
+		Args:   &types.Proposal_MinAmount{MinAmount: &types.MinAmountArgs{MinAmount: 1000}},
+	}}
+	blockedSender := types.MsgPropose{Proposal: types.Proposal{
+		Name:   "blocked sender",
+		Author: user1.String(),
+		Type:   types.PROPOSAL_TYPE_BLOCKED_SENDER_ADD,
+		Args:   &types.Proposal_Address{Address: &types.AddressArgs{Address: user2.String()}},
+	}}
+
+	s.NoError(s.k.Propose(s.ctx, paramChange(
+		types.ParamChange{Subspace: bank.ModuleName, Key: "minsend", Value: `"2000"`},
+		types.ParamChange{Subspace: bank.ModuleName, Key: "blockedsenders", Value: "[]"},
+	)))
+	s.ErrorIs(s.k.Propose(s.ctx, minSend), types.ErrOtherActive)
+	s.ErrorIs(s.k.Propose(s.ctx, blockedSender), types.ErrOtherActive)
+
+	s.True(minSend.Proposal.ConflictsWith(paramChange(
+		types.ParamChange{Subspace: bank.ModuleName, Key: "minsend", Value: `"2000"`},
+	).Proposal))
+	s.False(minSend.Proposal.ConflictsWith(paramChange(
+		types.ParamChange{Subspace: bank.ModuleName, Key: "dustd", Value: `"2000"`},
+	).Proposal))
+}
+
+func (s *Suite) TestParamChange_Categories() {
+	var (
+		user1 = app.DefaultGenesisUsers["user1"]
+		user2 = app.DefaultGenesisUsers["user2"]
+		user3 = app.DefaultGenesisUsers["user3"]
+		user4 = app.DefaultGenesisUsers["user4"]
+	)
+	s.k.AddGovernor(s.ctx, user4)
+	paramChange := func(changes ...types.ParamChange) types.MsgPropose {
+		return types.MsgPropose{Proposal: types.Proposal{
+			Name:   "param change",
+			Author: user1.String(),
+			Type:   types.PROPOSAL_TYPE_PARAM_CHANGE,
+			Args:   &types.Proposal_ParamChange{ParamChange: &types.ParamChangeArgs{Changes: changes}},
+		}}
+	}
+	isActive := func(id uint64) bool {
+		_, ok := s.k.GetActiveProposal(s.ctx, id)
+		return ok
+	}
+
+	price := paramChange(types.ParamChange{Subspace: "profile", Key: "SubscriptionPrice", Value: "2000"})
+	s.Equal([]types.ProposalCategory{types.PROPOSAL_CATEGORY_PRICE}, price.Proposal.Categories())
+	s.Equal(
+		[]types.ProposalCategory{types.PROPOSAL_CATEGORY_NODING, types.PROPOSAL_CATEGORY_PARAMS},
+		paramChange(
+			types.ParamChange{Subspace: "noding", Key: "JailAfter", Value: "20"},
+			types.ParamChange{Subspace: "noding", Key: "MaxMissedBlocksPerWindow", Value: "10"},
+		).Proposal.Categories(),
+	)
+
+	params := s.k.GetParams(s.ctx)
+	params.Threshold = util.Percent(50)
+	params.Quorum = util.Percent(50)
+	params.VetoShare = util.FractionInt(1)
+	params.CategoryThresholds = []types.CategoryThreshold{
+		{Category: types.PROPOSAL_CATEGORY_PRICE, Threshold: util.Percent(75)},
+	}
+	s.k.SetParams(s.ctx, params)
+
+	// A parameter no typed proposal changes needs the general threshold only
+	id := s.k.GetNextProposalId(s.ctx)
+	s.NoError(s.k.Propose(s.ctx, paramChange(types.ParamChange{Subspace: "voting", Key: "RevealPeriod", Value: "10"})))
+	s.NoError(s.k.Vote(s.ctx, user2, id, types.VOTE_OPTION_YES))
+	s.False(isActive(id))
+	s.EqualValues(10, s.k.GetParams(s.ctx).RevealPeriod)
+
+	// A price needs the price threshold, no matter how it's changed
+	id = s.k.GetNextProposalId(s.ctx)
+	s.NoError(s.k.Propose(s.ctx, price))
+	s.NoError(s.k.Vote(s.ctx, user2, id, types.VOTE_OPTION_YES))
+	s.True(isActive(id))
+	s.NoError(s.k.Vote(s.ctx, user3, id, types.VOTE_OPTION_YES))
+	s.False(isActive(id))
+	s.EqualValues(2000, s.app.GetProfileKeeper().GetParams(s.ctx).SubscriptionPrice)
+}
+
+func (s *Suite) TestExecutionStatus() {
+	var (
+		user1 = app.DefaultGenesisUsers["user1"]
+		user2 = app.DefaultGenesisUsers["user2"]
+		user3 = app.DefaultGenesisUsers["user3"]
+	)
+
+	s.ErrorIs(s.k.Propose(s.ctx, types.MsgPropose{Proposal: types.Proposal{
+		Name:   "add governor",
+		Author: user1.String(),
+		Type:   types.PROPOSAL_TYPE_GOVERNMENT_ADD,
+		Args:   &types.Proposal_Address{Address: &types.AddressArgs{Address: user2.String()}},
+	}}), types.ErrProposalCannotBeApplied)
+
+	s.NoError(s.k.Propose(s.ctx, types.MsgPropose{Proposal: types.Proposal{
+		Name:   "min send",
+		Author: user1.String(),
+		Type:   types.PROPOSAL_TYPE_MIN_SEND,
+		Args:   &types.Proposal_MinAmount{MinAmount: &types.MinAmountArgs{MinAmount: 1000}},
+	}}))
+	s.NoError(s.k.Vote(s.ctx, user2, 1, types.VOTE_OPTION_NO))
+	s.NoError(s.k.Vote(s.ctx, user3, 1, types.VOTE_OPTION_NO))
+
+	upgradeTime := s.ctx.BlockTime().Add(time.Hour)
+	s.NoError(s.k.Propose(s.ctx, types.MsgPropose{Proposal: types.Proposal{
+		Name:   "upgrade",
+		Author: user1.String(),
+		Type:   types.PROPOSAL_TYPE_SOFTWARE_UPGRADE,
+		Args:   &types.Proposal_SoftwareUpgrade{SoftwareUpgrade: &types.SoftwareUpgradeArgs{Name: "9.9.9", Time: &upgradeTime}},
+	}}))
+	s.NoError(s.k.Vote(s.ctx, user2, 2, types.VOTE_OPTION_YES))
+	s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(2 * time.Hour))
+	s.NoError(s.k.Vote(s.ctx, user3, 2, types.VOTE_OPTION_YES))
+
+	history := s.k.GetHistory(s.ctx, 10, 1)
+	s.Equal(2, len(history))
+	s.Equal(types.EXECUTION_STATUS_REJECTED, history[0].Status)
+	s.Empty(history[0].Error)
+	s.Equal(types.EXECUTION_STATUS_FAILED, history[1].Status)
+	s.NotEmpty(history[1].Error)
+
+	var found bool
+	for _, ev := range s.ctx.EventManager().Events() {
+		if ev.Type == "proposal_execution_failed" {
+			found = true
+		}
+	}
+	s.True(found)
+}
+
 type StatusSuite struct {
 	BaseSuite
 
@@ -270,7 +1091,7 @@ func (s *StatusSuite) TestStartPoll_Status() {
 	)
 	s.NoError(s.k.StartPoll(s.ctx, orig))
 
-	got, ok := s.k.GetCurrentPoll(s.ctx)
+	got, ok := s.k.GetPoll(s.ctx, 1)
 	s.True(ok)
 	s.Equal(orig.Author, got.Author)
 	s.Equal(orig.Name, got.Name)
@@ -282,7 +1103,7 @@ func (s *StatusSuite) TestStartPoll_Status() {
 	s.NotNil(got.EndTime)
 	s.Equal(s.ctx.BlockTime().Add(18*time.Hour), *got.EndTime)
 
-	y, n := s.k.GetPollStatus(s.ctx)
+	y, n := s.k.GetPollStatus(s.ctx, 1)
 	s.EqualValues(0, y)
 	s.EqualValues(0, n)
 }
@@ -302,11 +1123,11 @@ func (s *StatusSuite) TestVotePoll_Status() {
 	)
 	s.NoError(s.k.StartPoll(s.ctx, poll))
 
-	s.NoError(s.k.Answer(s.ctx, root, true))
-	s.Error(s.k.Answer(s.ctx, user1, false))
-	s.Error(s.k.Answer(s.ctx, user2, true))
+	s.NoError(s.k.Answer(s.ctx, 1, root, true, 0))
+	s.Error(s.k.Answer(s.ctx, 1, user1, false, 0))
+	s.Error(s.k.Answer(s.ctx, 1, user2, true, 0))
 
-	y, n := s.k.GetPollStatus(s.ctx)
+	y, n := s.k.GetPollStatus(s.ctx, 1)
 	s.EqualValues(1, y)
 	s.EqualValues(0, n)
 }
@@ -30,13 +30,25 @@ func (ms MsgServer) Vote(ctx context.Context, msg *types.MsgVote) (*types.MsgVot
 		sdkCtx = sdk.UnwrapSDKContext(ctx)
 		k      = Keeper(ms)
 	)
-	if err := k.Vote(sdkCtx, msg.GetVoter(), msg.Agree); err != nil {
+	if err := k.Vote(sdkCtx, msg.GetVoter(), msg.ProposalId, msg.GetOption()); err != nil {
 		return nil, err
 	}
 	util.TagTx(sdkCtx, types.ModuleName, msg)
 	return &types.MsgVoteResponse{}, nil
 }
 
+func (ms MsgServer) ChangeVote(ctx context.Context, msg *types.MsgChangeVote) (*types.MsgChangeVoteResponse, error) {
+	var (
+		sdkCtx = sdk.UnwrapSDKContext(ctx)
+		k      = Keeper(ms)
+	)
+	if err := k.ChangeVote(sdkCtx, msg.GetVoter(), msg.ProposalId, msg.GetOption()); err != nil {
+		return nil, err
+	}
+	util.TagTx(sdkCtx, types.ModuleName, msg)
+	return &types.MsgChangeVoteResponse{}, nil
+}
+
 func (ms MsgServer) StartPoll(ctx context.Context, msg *types.MsgStartPoll) (*types.MsgStartPollResponse, error) {
 	var (
 		sdkCtx = sdk.UnwrapSDKContext(ctx)
@@ -54,9 +66,45 @@ func (ms MsgServer) AnswerPoll(ctx context.Context, msg *types.MsgAnswerPoll) (*
 		sdkCtx = sdk.UnwrapSDKContext(ctx)
 		k      = Keeper(ms)
 	)
-	if err := k.Answer(sdkCtx, msg.Respondent, msg.Yes); err != nil {
+	if err := k.Answer(sdkCtx, msg.PollId, msg.Respondent, msg.Yes, msg.Option); err != nil {
 		return nil, err
 	}
 	util.TagTx(sdkCtx, types.ModuleName, msg)
 	return &types.MsgAnswerPollResponse{}, nil
 }
+
+func (ms MsgServer) CommitAnswer(ctx context.Context, msg *types.MsgCommitAnswer) (*types.MsgCommitAnswerResponse, error) {
+	var (
+		sdkCtx = sdk.UnwrapSDKContext(ctx)
+		k      = Keeper(ms)
+	)
+	if err := k.CommitAnswer(sdkCtx, msg.PollId, msg.Respondent, msg.Hash); err != nil {
+		return nil, err
+	}
+	util.TagTx(sdkCtx, types.ModuleName, msg)
+	return &types.MsgCommitAnswerResponse{}, nil
+}
+
+func (ms MsgServer) RevealAnswer(ctx context.Context, msg *types.MsgRevealAnswer) (*types.MsgRevealAnswerResponse, error) {
+	var (
+		sdkCtx = sdk.UnwrapSDKContext(ctx)
+		k      = Keeper(ms)
+	)
+	if err := k.RevealAnswer(sdkCtx, msg.PollId, msg.Respondent, msg.Yes, msg.Option, msg.Salt); err != nil {
+		return nil, err
+	}
+	util.TagTx(sdkCtx, types.ModuleName, msg)
+	return &types.MsgRevealAnswerResponse{}, nil
+}
+
+func (ms MsgServer) CancelPoll(ctx context.Context, msg *types.MsgCancelPoll) (*types.MsgCancelPollResponse, error) {
+	var (
+		sdkCtx = sdk.UnwrapSDKContext(ctx)
+		k      = Keeper(ms)
+	)
+	if err := k.CancelPoll(sdkCtx, msg.PollId, msg.Signer); err != nil {
+		return nil, err
+	}
+	util.TagTx(sdkCtx, types.ModuleName, msg)
+	return &types.MsgCancelPollResponse{}, nil
+}
@@ -17,8 +17,8 @@ func NewQuerier(k Keeper, legacyQuerierCdc *codec.LegacyAmino) sdk.Querier {
 			return queryParams(ctx, k, legacyQuerierCdc)
 		case types.QueryGovernment:
 			return queryGovernment(ctx, k, legacyQuerierCdc)
-		case types.QueryCurrent:
-			return queryCurrent(ctx, k, legacyQuerierCdc)
+		case types.QueryActive:
+			return queryActive(ctx, k, req, legacyQuerierCdc)
 		case types.QueryHistory:
 			return queryHistory(ctx, k, req, legacyQuerierCdc)
 		default:
@@ -69,31 +69,20 @@ func queryGovernment(ctx sdk.Context, k Keeper, legacyQuerierCdc *codec.LegacyAm
 	return res, nil
 }
 
-func queryCurrent(ctx sdk.Context, k Keeper, legacyQuerierCdc *codec.LegacyAmino) ([]byte, error) {
-	proposal := k.GetCurrentProposal(ctx)
+func queryActive(ctx sdk.Context, k Keeper, req abci.RequestQuery, legacyQuerierCdc *codec.LegacyAmino) ([]byte, error) {
+	var params types.ActiveRequest
 
-	var (
-		res []byte
-		err error
-	)
-
-	if proposal == nil {
-		res, err = codec.MarshalJSONIndent(legacyQuerierCdc, nil)
-	} else {
-		gov := k.GetGovernment(ctx)
-		agreed := k.GetAgreed(ctx)
-		disagreed := k.GetDisagreed(ctx)
-		res, err = codec.MarshalJSONIndent(
-			legacyQuerierCdc,
-			types.CurrentResponse{
-				Proposal:   *proposal,
-				Government: gov.Members,
-				Agreed:     agreed.Members,
-				Disagreed:  disagreed.Members,
-			},
-		)
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 	}
 
+	res, err := codec.MarshalJSONIndent(
+		legacyQuerierCdc,
+		types.ActiveResponse{
+			Proposals:  k.GetActiveProposals(ctx, params.Limit, params.Page),
+			Government: k.GetGovernment(ctx).Members,
+		},
+	)
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
 	}
@@ -37,29 +37,14 @@ func (qs QueryServer) Government(ctx context.Context, _ *types.GovernmentRequest
 	}, nil
 }
 
-func (qs QueryServer) Current(ctx context.Context, _ *types.CurrentRequest) (*types.CurrentResponse, error) {
+func (qs QueryServer) Active(ctx context.Context, req *types.ActiveRequest) (*types.ActiveResponse, error) {
 	var (
 		sdkCtx = sdk.UnwrapSDKContext(ctx)
 		k      = Keeper(qs)
 	)
-	var (
-		proposal = k.GetCurrentProposal(sdkCtx)
-		gov,
-		agreed,
-		disagreed types.Government
-	)
-	if proposal == nil {
-		proposal = new(types.Proposal)
-	} else {
-		gov = k.GetGovernment(sdkCtx)
-		agreed = k.GetAgreed(sdkCtx)
-		disagreed = k.GetDisagreed(sdkCtx)
-	}
-	return &types.CurrentResponse{
-		Proposal:   *proposal,
-		Government: gov.Strings(),
-		Agreed:     agreed.Strings(),
-		Disagreed:  disagreed.Strings(),
+	return &types.ActiveResponse{
+		Proposals:  k.GetActiveProposals(sdkCtx, req.Limit, req.Page),
+		Government: k.GetGovernment(sdkCtx).Strings(),
 	}, nil
 }
 
@@ -74,21 +59,36 @@ func (qs QueryServer) Params(ctx context.Context, _ *types.ParamsRequest) (*type
 	}, nil
 }
 
-func (qs QueryServer) Poll(ctx context.Context, _ *types.PollRequest) (*types.PollResponse, error) {
+func (qs QueryServer) Poll(ctx context.Context, req *types.PollRequest) (*types.PollResponse, error) {
 	var (
 		sdkCtx = sdk.UnwrapSDKContext(ctx)
 		k      = Keeper(qs)
 	)
-	poll, ok := k.GetCurrentPoll(sdkCtx)
+	if req.Id == 0 {
+		return &types.PollResponse{Active: k.GetActivePolls(sdkCtx)}, nil
+	}
+
+	poll, ok := k.GetPoll(sdkCtx, req.Id)
 	if !ok {
-		return nil, status.Error(codes.NotFound, "There is no active poll at the moment")
+		return nil, status.Errorf(codes.NotFound, "There is no active poll #%d", req.Id)
 	}
-	yes, no := k.GetPollStatus(sdkCtx)
+	yes, no := k.GetPollStatus(sdkCtx, req.Id)
 
 	return &types.PollResponse{
-		Poll: poll,
-		Yes:  yes,
-		No:   no,
+		Poll:    poll,
+		Yes:     yes,
+		No:      no,
+		Tallies: k.GetPollTallies(sdkCtx, req.Id),
+	}, nil
+}
+
+func (qs QueryServer) PendingChanges(ctx context.Context, _ *types.PendingChangesRequest) (*types.PendingChangesResponse, error) {
+	var (
+		sdkCtx = sdk.UnwrapSDKContext(ctx)
+		k      = Keeper(qs)
+	)
+	return &types.PendingChangesResponse{
+		Changes: k.GetPendingChanges(sdkCtx),
 	}, nil
 }
 
@@ -97,6 +97,14 @@ func (qs QueryServer) PollHistory(ctx context.Context, req *types.PollHistoryReq
 		sdkCtx = sdk.UnwrapSDKContext(ctx)
 		k      = Keeper(qs)
 	)
+	if req.Id != 0 {
+		item, ok := k.GetPollHistoryItem(sdkCtx, req.Id)
+		if !ok {
+			return nil, status.Errorf(codes.NotFound, "There is no completed poll #%d", req.Id)
+		}
+		return &types.PollHistoryResponse{History: []types.PollHistoryItem{item}}, nil
+	}
+
 	data := k.GetPollHistory(sdkCtx, req.Limit, req.Page)
 	return &types.PollHistoryResponse{History: data}, nil
 }
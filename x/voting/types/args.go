@@ -1,9 +1,13 @@
 package types
 
 import (
+	"encoding/json"
+
 	"github.com/pkg/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	profile "github.com/arterynetwork/artr/x/profile/types"
 )
 
 func (args *PriceArgs) Validate() error           { return nil }
@@ -13,6 +17,10 @@ func (args *AddressArgs) Validate() error {
 	_, err := sdk.AccAddressFromBech32(args.Address)
 	return err
 }
+func (args *StaffValidatorArgs) Validate() error {
+	_, err := sdk.AccAddressFromBech32(args.Address)
+	return err
+}
 func (args *SoftwareUpgradeArgs) Validate() error {
 	if args.Name == "" {
 		return errors.New("empty upgrade name")
@@ -36,6 +44,59 @@ func (args *PeriodArgs) Validate() error {
 	return nil
 }
 func (args *RevokeArgs) Validate() error { return args.Revoke.Validate() }
+func (args *CompanyAccountsArgs) Validate() error {
+	if args.CompanyAccounts == nil {
+		return errors.New("company accounts are nil")
+	}
+	return args.CompanyAccounts.Validate()
+}
+func (args *ParamChangeArgs) Validate() error {
+	if len(args.Changes) == 0 {
+		return errors.New("no changes")
+	}
+	seen := make(map[string]bool, len(args.Changes))
+	for i, change := range args.Changes {
+		if change.Subspace == "" {
+			return errors.Errorf("invalid change #%d: empty subspace", i)
+		}
+		if change.Key == "" {
+			return errors.Errorf("invalid change #%d: empty key", i)
+		}
+		if !json.Valid([]byte(change.Value)) {
+			return errors.Errorf("invalid change #%d: value is not a valid JSON", i)
+		}
+		if change.IsImmutable() {
+			return errors.Errorf("invalid change #%d: %s cannot be changed", i, change.Target())
+		}
+		if seen[change.Target()] {
+			return errors.Errorf("invalid change #%d: %s is changed twice", i, change.Target())
+		}
+		seen[change.Target()] = true
+	}
+	return nil
+}
+
+// Target returns the changed parameter in the same "module/Key" form proposal targets use.
+func (change ParamChange) Target() string { return change.Subspace + "/" + change.Key }
+
+// Category returns the category of the typed proposal changing the same parameter, or PARAMS if there is no such one.
+func (change ParamChange) Category() ProposalCategory {
+	if category, ok := targetCategories[change.Target()]; ok {
+		return category
+	}
+	return PROPOSAL_CATEGORY_PARAMS
+}
+
+// immutableParams are never changed once set, but their module keepers guard it, not the params subspaces.
+//
+// Profile CardMagic is one of them: card numbers are derived from it on the fly, so changing it would change every
+// card number issued so far. That's why there is no typed proposal (nor a CLI command) for it.
+var immutableParams = map[string]bool{
+	paramTarget(profile.ModuleName, profile.KeyCardMagic): true,
+}
+
+// IsImmutable reports whether the parameter must not be changed by a proposal.
+func (change ParamChange) IsImmutable() bool { return immutableParams[change.Target()] }
 
 func (args *AddressArgs) GetAddress() sdk.AccAddress {
 	addr, err := sdk.AccAddressFromBech32(args.Address)
@@ -44,3 +105,11 @@ func (args *AddressArgs) GetAddress() sdk.AccAddress {
 	}
 	return addr
 }
+
+func (args *StaffValidatorArgs) GetAddress() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(args.Address)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
@@ -13,8 +13,12 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	// Messages
 	cdc.RegisterConcrete(MsgPropose{}, ModuleName+"/CreateProposal", nil)
 	cdc.RegisterConcrete(MsgVote{}, ModuleName+"/ProposalVote", nil)
+	cdc.RegisterConcrete(MsgChangeVote{}, ModuleName+"/ProposalChangeVote", nil)
 	cdc.RegisterConcrete(MsgStartPoll{}, ModuleName+"/StartPoll", nil)
 	cdc.RegisterConcrete(MsgAnswerPoll{}, ModuleName+"/AnswerPoll", nil)
+	cdc.RegisterConcrete(MsgCommitAnswer{}, ModuleName+"/CommitAnswer", nil)
+	cdc.RegisterConcrete(MsgRevealAnswer{}, ModuleName+"/RevealAnswer", nil)
+	cdc.RegisterConcrete(MsgCancelPoll{}, ModuleName+"/CancelPoll", nil)
 	// Proposal Params
 	cdc.RegisterConcrete(PriceArgs{}, ModuleName+"/PriceArgs", nil)
 	cdc.RegisterConcrete(Proposal_Price{}, ModuleName+"/PriceArgsWrap", nil)
@@ -40,8 +44,12 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgPropose{},
 		&MsgVote{},
+		&MsgChangeVote{},
 		&MsgStartPoll{},
 		&MsgAnswerPoll{},
+		&MsgCommitAnswer{},
+		&MsgRevealAnswer{},
+		&MsgCancelPoll{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
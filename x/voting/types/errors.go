@@ -15,4 +15,11 @@ var (
 	ErrProposalGovernorLast      = sdkerrors.Register(ModuleName, 7, "cannot remove the last governor")
 	ErrNoActivePoll              = sdkerrors.Register(ModuleName, 8, "no active poll")
 	ErrRespondentNotAllowed      = sdkerrors.Register(ModuleName, 9, "poll requirements don't match")
+	ErrProposalCannotBeApplied   = sdkerrors.Register(ModuleName, 10, "proposal cannot be applied")
+	ErrNotVoted                  = sdkerrors.Register(ModuleName, 11, "not voted yet")
+	ErrInvalidPollOption         = sdkerrors.Register(ModuleName, 12, "invalid poll option")
+	ErrPollMode                  = sdkerrors.Register(ModuleName, 13, "wrong poll mode")
+	ErrPollPhase                 = sdkerrors.Register(ModuleName, 14, "wrong poll phase")
+	ErrCommitmentMismatch        = sdkerrors.Register(ModuleName, 15, "answer doesn't match the commitment")
+	ErrPollPeriod                = sdkerrors.Register(ModuleName, 16, "poll period out of bounds")
 )
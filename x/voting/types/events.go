@@ -4,6 +4,12 @@ func (EventProposalCreated) XXX_MessageName() string { return "proposal_created"
 
 func (EventProposalVote) XXX_MessageName() string { return "proposal_vote" }
 
+func (EventProposalVoteChanged) XXX_MessageName() string { return "proposal_vote_changed" }
+
 func (EventVotingFinished) XXX_MessageName() string { return "voting_finished" }
 
+func (EventProposalExecutionFailed) XXX_MessageName() string { return "proposal_execution_failed" }
+
+func (EventProposalActivated) XXX_MessageName() string { return "proposal_activated" }
+
 func (EventPollFinished) XXX_MessageName() string { return "poll_finished" }
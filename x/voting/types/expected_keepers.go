@@ -24,7 +24,7 @@ type ParamSubspace interface {
 
 type ScheduleKeeper interface {
 	ScheduleTask(ctx sdk.Context, time time.Time, event string, data []byte)
-	DeleteAll(ctx sdk.Context, time time.Time, event string)
+	Delete(ctx sdk.Context, time time.Time, event string, payload []byte)
 }
 
 type UprgadeKeeper interface {
@@ -33,7 +33,7 @@ type UprgadeKeeper interface {
 }
 
 type NodingKeeper interface {
-	AddToStaff(ctx sdk.Context, acc sdk.AccAddress) error
+	AddToStaff(ctx sdk.Context, acc sdk.AccAddress, proposal, author string, days uint32) error
 	RemoveFromStaff(ctx sdk.Context, acc sdk.AccAddress) error
 
 	GetParams(ctx sdk.Context) (params noding.Params)
@@ -81,4 +81,9 @@ type BankKeeper interface {
 	SetParams(ctx sdk.Context, params bank.Params)
 	AddBlockedSender(ctx sdk.Context, acc sdk.AccAddress)
 	RemoveBlockedSender(ctx sdk.Context, acc sdk.AccAddress)
+	GetBalance(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 }
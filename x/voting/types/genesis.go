@@ -13,18 +13,12 @@ func DefaultGenesisState() *GenesisState {
 	}
 }
 
-func NewGenesisState(params Params, gov Government, current *Proposal, start int64, agreed, disagreed Government, history []ProposalHistoryRecord) *GenesisState {
-	var currentProposal Proposal
-	if current != nil {
-		currentProposal = *current
-	}
+func NewGenesisState(params Params, gov Government, active []ActiveProposal, nextProposalId uint64, history []ProposalHistoryRecord) *GenesisState {
 	return &GenesisState{
 		Params:          params,
 		Government:      gov.Members,
-		CurrentProposal: currentProposal,
-		StartBlock:      start,
-		Agreed:          agreed.Members,
-		Disagreed:       disagreed.Members,
+		ActiveProposals: active,
+		NextProposalId:  nextProposalId,
 		History:         history,
 	}
 }
@@ -70,10 +64,74 @@ func ValidateGenesis(data GenesisState) error {
 			}
 		}
 	}
+	ids := make(map[uint64]bool, len(data.ActiveProposals))
+	for i, ap := range data.ActiveProposals {
+		if err := ap.Validate(); err != nil {
+			return errors.Wrapf(err, "invalid active_proposals (item #%d)", i)
+		}
+		if ids[ap.Proposal.Id] {
+			return errors.Errorf("invalid active_proposals (item #%d): duplicate id %d", i, ap.Proposal.Id)
+		}
+		ids[ap.Proposal.Id] = true
+		if ap.Proposal.Id >= data.NextProposalId {
+			return errors.Errorf("invalid active_proposals (item #%d): id %d must be less than next_proposal_id", i, ap.Proposal.Id)
+		}
+	}
 	for i, r := range data.History {
 		if err := r.Validate(); err != nil {
 			return errors.Wrapf(err, "invalid history (item #%d)", i)
 		}
 	}
+	for i, pc := range data.PendingChanges {
+		if err := pc.Validate(); err != nil {
+			return errors.Wrapf(err, "invalid pending_changes (item #%d)", i)
+		}
+		if ids[pc.Proposal.Id] {
+			return errors.Errorf("invalid pending_changes (item #%d): duplicate id %d", i, pc.Proposal.Id)
+		}
+		ids[pc.Proposal.Id] = true
+		if pc.Proposal.Id >= data.NextProposalId {
+			return errors.Errorf("invalid pending_changes (item #%d): id %d must be less than next_proposal_id", i, pc.Proposal.Id)
+		}
+	}
+	if data.CurrentPoll != nil {
+		if err := data.CurrentPoll.Validate(); err != nil {
+			return errors.Wrap(err, "invalid current_poll")
+		}
+	}
+	pollIds := make(map[uint64]bool, len(data.ActivePolls))
+	for i, poll := range data.ActivePolls {
+		if err := poll.Validate(); err != nil {
+			return errors.Wrapf(err, "invalid active_polls (item #%d)", i)
+		}
+		if poll.Id == 0 || pollIds[poll.Id] {
+			return errors.Errorf("invalid active_polls (item #%d): zero or duplicate id %d", i, poll.Id)
+		}
+		pollIds[poll.Id] = true
+		if poll.Id >= data.NextPollId {
+			return errors.Errorf("invalid active_polls (item #%d): id %d must be less than next_poll_id", i, poll.Id)
+		}
+	}
+	hasPoll := func(id uint64) bool {
+		if id == 0 {
+			return data.CurrentPoll != nil
+		}
+		return pollIds[id]
+	}
+	for i, ans := range data.PollAnswers {
+		if !hasPoll(ans.PollId) {
+			return errors.Errorf("invalid poll_answers (item #%d): unknown poll %d", i, ans.PollId)
+		}
+	}
+	for i, w := range data.PollWeights {
+		if !hasPoll(w.PollId) {
+			return errors.Errorf("invalid poll_weights (item #%d): unknown poll %d", i, w.PollId)
+		}
+	}
+	for i, c := range data.PollCommitments {
+		if !hasPoll(c.PollId) {
+			return errors.Errorf("invalid poll_commitments (item #%d): unknown poll %d", i, c.PollId)
+		}
+	}
 	return nil
 }
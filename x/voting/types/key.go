@@ -15,25 +15,45 @@ const (
 
 	VoteHookName = ModuleName + "/complete"
 	PollHookName = ModuleName + "/poll"
+	// ActivateHookName is a hook applying an agreed proposal at its activation time.
+	ActivateHookName = ModuleName + "/activate"
 )
 
 var (
-	KeyGovernment       = []byte("government")
+	KeyGovernment     = []byte("government")
+	KeyTotalVotes     = []byte("total_votes")
+	KeyTotalAgreed    = []byte("total_agreed")
+	KeyTotalDisagreed = []byte("total_disagreed")
+	KeyHistoryPrefix  = []byte("h")
+	KeyActivePrefix   = []byte("active/")
+	KeyNextProposalId = []byte("next_proposal_id")
+	KeyPendingPrefix  = []byte("pending/")
+
+	// Legacy keys of the only proposal that could be active before proposals got their IDs. They are kept for the
+	// sake of the store migration only.
 	KeyAgreedMembers    = []byte("agreed")
 	KeyDisagreedMembers = []byte("disagreed")
 	KeyCurrentVote      = []byte("current_vote")
-	KeyTotalVotes       = []byte("total_votes")
-	KeyTotalAgreed      = []byte("total_agreed")
-	KeyTotalDisagreed   = []byte("total_disagreed")
 	KeyStartBlock       = []byte("start_block")
-	KeyHistoryPrefix    = []byte("h")
-
-	KeyPollPrefix   = []byte("p/")
-	KeyPollCurrent  = []byte("q")
-	KeyPollAnswers  = []byte("a/")
-	KeyPollYesCount = []byte("y")
-	KeyPollNoCount  = []byte("n")
-	KeyPollHistory  = []byte("h/")
+
+	KeyPollPrefix  = []byte("p/")
+	KeyPollActive  = []byte("o/")
+	KeyPollHistory = []byte("f/")
+	KeyPollNextId  = []byte("i")
+
+	// KeyPollData is a prefix of a poll's own store (followed by the poll ID), which holds the keys below.
+	KeyPollData        = []byte("d/")
+	KeyPollAnswers     = []byte("a/")
+	KeyPollYesCount    = []byte("y")
+	KeyPollNoCount     = []byte("n")
+	KeyPollWeights     = []byte("w/")
+	KeyPollTallies     = []byte("c/")
+	KeyPollCommitments = []byte("s/")
+
+	// Legacy keys of the only poll that could be active before polls got their IDs (its data was kept right under
+	// KeyPollPrefix) and of the poll history keyed by end time. They are kept for the sake of the store migration only.
+	KeyPollCurrent       = []byte("q")
+	KeyPollLegacyHistory = []byte("h/")
 
 	ValueYes = []byte("y")
 	ValueNo  = []byte("n")
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"crypto/sha256"
+
 	"github.com/golang/protobuf/proto"
 	"github.com/pkg/errors"
 
@@ -10,13 +12,21 @@ import (
 var (
 	_ sdk.Msg = &MsgPropose{}
 	_ sdk.Msg = &MsgVote{}
+	_ sdk.Msg = &MsgChangeVote{}
+	_ sdk.Msg = &MsgCommitAnswer{}
+	_ sdk.Msg = &MsgRevealAnswer{}
+	_ sdk.Msg = &MsgCancelPoll{}
 )
 
 const (
-	ProposeConst    = "propose"
-	VoteConst       = "vote"
-	StartPollConst  = "start_poll"
-	AnswerPollConst = "answer_poll"
+	ProposeConst      = "propose"
+	VoteConst         = "vote"
+	ChangeVoteConst   = "change_vote"
+	StartPollConst    = "start_poll"
+	AnswerPollConst   = "answer_poll"
+	CommitAnswerConst = "commit_answer"
+	RevealAnswerConst = "reveal_answer"
+	CancelPollConst   = "cancel_poll"
 )
 
 func (MsgPropose) Route() string { return RouterKey }
@@ -25,6 +35,9 @@ func (msg MsgPropose) ValidateBasic() error {
 	if msg.Proposal.EndTime != nil {
 		return errors.New("end_time should be empty")
 	}
+	if msg.Proposal.Id != 0 {
+		return errors.New("id should be empty")
+	}
 	return msg.Proposal.Validate()
 }
 
@@ -48,6 +61,12 @@ func (msg MsgVote) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(msg.Voter); err != nil {
 		return errors.Wrap(err, "invalid voter")
 	}
+	if _, ok := VoteOption_name[int32(msg.Option)]; !ok {
+		return errors.Errorf("invalid option: %d", msg.Option)
+	}
+	if msg.ProposalId == 0 {
+		return errors.New("proposal_id is missing")
+	}
 	return nil
 }
 
@@ -63,6 +82,17 @@ func (msg MsgVote) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.GetVoter()}
 }
 
+// GetOption returns the vote option, falling back to the legacy "agree" flag if it's unspecified.
+func (msg MsgVote) GetOption() VoteOption {
+	if msg.Option != VOTE_OPTION_UNSPECIFIED {
+		return msg.Option
+	}
+	if msg.Agree {
+		return VOTE_OPTION_YES
+	}
+	return VOTE_OPTION_NO
+}
+
 func (msg MsgVote) GetVoter() sdk.AccAddress {
 	addr, err := sdk.AccAddressFromBech32(msg.Voter)
 	if err != nil {
@@ -71,6 +101,54 @@ func (msg MsgVote) GetVoter() sdk.AccAddress {
 	return addr
 }
 
+func (MsgChangeVote) Route() string { return RouterKey }
+
+func (MsgChangeVote) Type() string { return ChangeVoteConst }
+
+func (msg MsgChangeVote) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Voter); err != nil {
+		return errors.Wrap(err, "invalid voter")
+	}
+	if _, ok := VoteOption_name[int32(msg.Option)]; !ok {
+		return errors.Errorf("invalid option: %d", msg.Option)
+	}
+	if msg.ProposalId == 0 {
+		return errors.New("proposal_id is missing")
+	}
+	return nil
+}
+
+func (msg *MsgChangeVote) GetSignBytes() []byte {
+	bz, err := proto.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+func (msg MsgChangeVote) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.GetVoter()}
+}
+
+// GetOption returns the vote option, falling back to the legacy "agree" flag if it's unspecified.
+func (msg MsgChangeVote) GetOption() VoteOption {
+	if msg.Option != VOTE_OPTION_UNSPECIFIED {
+		return msg.Option
+	}
+	if msg.Agree {
+		return VOTE_OPTION_YES
+	}
+	return VOTE_OPTION_NO
+}
+
+func (msg MsgChangeVote) GetVoter() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Voter)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
 func (MsgStartPoll) Route() string { return RouterKey }
 
 func (MsgStartPoll) Type() string { return StartPollConst }
@@ -82,6 +160,15 @@ func (msg MsgStartPoll) ValidateBasic() error {
 	if msg.Poll.EndTime != nil {
 		return errors.New("end_time should be empty")
 	}
+	if msg.Poll.RevealEndTime != nil {
+		return errors.New("reveal_end_time should be empty")
+	}
+	if msg.Poll.Id != 0 {
+		return errors.New("id should be empty")
+	}
+	if len(msg.Poll.CancelVotes) != 0 {
+		return errors.New("cancel_votes should be empty")
+	}
 	return msg.Poll.Validate()
 }
 
@@ -113,6 +200,9 @@ func (msg MsgAnswerPoll) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(msg.Respondent); err != nil {
 		return errors.Wrap(err, "cannot parse respondent")
 	}
+	if msg.PollId == 0 {
+		return errors.New("poll_id must be set")
+	}
 	return nil
 }
 
@@ -135,3 +225,108 @@ func (msg MsgAnswerPoll) GetRespondent() sdk.AccAddress {
 	}
 	return res
 }
+
+func (MsgCommitAnswer) Route() string { return RouterKey }
+
+func (MsgCommitAnswer) Type() string { return CommitAnswerConst }
+
+func (msg MsgCommitAnswer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Respondent); err != nil {
+		return errors.Wrap(err, "cannot parse respondent")
+	}
+	if msg.PollId == 0 {
+		return errors.New("poll_id must be set")
+	}
+	if len(msg.Hash) != sha256.Size {
+		return errors.Errorf("hash must be %d bytes long", sha256.Size)
+	}
+	return nil
+}
+
+func (msg *MsgCommitAnswer) GetSignBytes() []byte {
+	bz, err := proto.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+func (msg MsgCommitAnswer) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.GetRespondent()}
+}
+
+func (msg MsgCommitAnswer) GetRespondent() sdk.AccAddress {
+	res, err := sdk.AccAddressFromBech32(msg.Respondent)
+	if err != nil {
+		panic(err)
+	}
+	return res
+}
+
+func (MsgRevealAnswer) Route() string { return RouterKey }
+
+func (MsgRevealAnswer) Type() string { return RevealAnswerConst }
+
+func (msg MsgRevealAnswer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Respondent); err != nil {
+		return errors.Wrap(err, "cannot parse respondent")
+	}
+	if msg.PollId == 0 {
+		return errors.New("poll_id must be set")
+	}
+	return nil
+}
+
+func (msg *MsgRevealAnswer) GetSignBytes() []byte {
+	bz, err := proto.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+func (msg MsgRevealAnswer) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.GetRespondent()}
+}
+
+func (msg MsgRevealAnswer) GetRespondent() sdk.AccAddress {
+	res, err := sdk.AccAddressFromBech32(msg.Respondent)
+	if err != nil {
+		panic(err)
+	}
+	return res
+}
+
+func (MsgCancelPoll) Route() string { return RouterKey }
+
+func (MsgCancelPoll) Type() string { return CancelPollConst }
+
+func (msg MsgCancelPoll) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errors.Wrap(err, "cannot parse signer")
+	}
+	if msg.PollId == 0 {
+		return errors.New("poll_id must be set")
+	}
+	return nil
+}
+
+func (msg *MsgCancelPoll) GetSignBytes() []byte {
+	bz, err := proto.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+func (msg MsgCancelPoll) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.GetSigner()}
+}
+
+func (msg MsgCancelPoll) GetSigner() sdk.AccAddress {
+	res, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return res
+}
@@ -16,12 +16,24 @@ const (
 	DefaultParamspace = ModuleName
 
 	DefaultVotingPeriod int32 = util.BlocksOneDay
+	DefaultRevealPeriod int32 = 24
+
+	DefaultMinPollPeriod int32 = 1
+	DefaultMaxPollPeriod int32 = 24 * 30
 )
 
 // Parameter store keys
 var (
-	KeyParamVotingPeriod = []byte("VotingPeriod")
-	KeyParamPollPeriod   = []byte("PollPeriod")
+	KeyParamVotingPeriod       = []byte("VotingPeriod")
+	KeyParamPollPeriod         = []byte("PollPeriod")
+	KeyParamThreshold          = []byte("Threshold")
+	KeyParamQuorum             = []byte("Quorum")
+	KeyParamCategoryThresholds = []byte("CategoryThresholds")
+	KeyParamVetoShare          = []byte("VetoShare")
+	KeyParamRevealPeriod       = []byte("RevealPeriod")
+	KeyParamMinPollPeriod      = []byte("MinPollPeriod")
+	KeyParamMaxPollPeriod      = []byte("MaxPollPeriod")
+	KeyParamProposalDeposit    = []byte("ProposalDeposit")
 )
 
 // ParamKeyTable for voting module
@@ -30,10 +42,26 @@ func ParamKeyTable() params.KeyTable {
 }
 
 // NewParams creates a new Params object
-func NewParams(votingPeriod, pollPeriod int32) Params {
+func NewParams(
+	votingPeriod, pollPeriod int32,
+	threshold, quorum util.Fraction,
+	categoryThresholds []CategoryThreshold,
+	vetoShare util.Fraction,
+	revealPeriod int32,
+	minPollPeriod, maxPollPeriod int32,
+	proposalDeposit int64,
+) Params {
 	return Params{
-		VotingPeriod: votingPeriod,
-		PollPeriod:   pollPeriod,
+		VotingPeriod:       votingPeriod,
+		PollPeriod:         pollPeriod,
+		Threshold:          threshold,
+		Quorum:             quorum,
+		CategoryThresholds: categoryThresholds,
+		VetoShare:          vetoShare,
+		RevealPeriod:       revealPeriod,
+		MinPollPeriod:      minPollPeriod,
+		MaxPollPeriod:      maxPollPeriod,
+		ProposalDeposit:    proposalDeposit,
 	}
 }
 
@@ -51,12 +79,20 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 	return params.ParamSetPairs{
 		params.NewParamSetPair(KeyParamVotingPeriod, &p.VotingPeriod, validateVotingPeriod),
 		params.NewParamSetPair(KeyParamPollPeriod, &p.PollPeriod, validateVotingPeriod),
+		params.NewParamSetPair(KeyParamThreshold, &p.Threshold, validateThreshold),
+		params.NewParamSetPair(KeyParamQuorum, &p.Quorum, validateQuorum),
+		params.NewParamSetPair(KeyParamCategoryThresholds, &p.CategoryThresholds, validateCategoryThresholds),
+		params.NewParamSetPair(KeyParamVetoShare, &p.VetoShare, validateVetoShare),
+		params.NewParamSetPair(KeyParamRevealPeriod, &p.RevealPeriod, validateVotingPeriod),
+		params.NewParamSetPair(KeyParamMinPollPeriod, &p.MinPollPeriod, validateVotingPeriod),
+		params.NewParamSetPair(KeyParamMaxPollPeriod, &p.MaxPollPeriod, validateVotingPeriod),
+		params.NewParamSetPair(KeyParamProposalDeposit, &p.ProposalDeposit, validateProposalDeposit),
 	}
 }
 
 // DefaultParams defines the parameters for this module
 func DefaultParams() Params {
-	return NewParams(DefaultVotingPeriod, DefaultVotingPeriod)
+	return NewParams(DefaultVotingPeriod, DefaultVotingPeriod, util.NewFraction(2, 3), util.FractionInt(1), []CategoryThreshold{}, util.NewFraction(1, 3), DefaultRevealPeriod, DefaultMinPollPeriod, DefaultMaxPollPeriod, 0)
 }
 
 func (p Params) Validate() error {
@@ -66,9 +102,96 @@ func (p Params) Validate() error {
 	if err := validateVotingPeriod(p.PollPeriod); err != nil {
 		return errors.Wrap(err, "invalid poll_period")
 	}
+	if err := validateThreshold(p.Threshold); err != nil {
+		return errors.Wrap(err, "invalid threshold")
+	}
+	if err := validateQuorum(p.Quorum); err != nil {
+		return errors.Wrap(err, "invalid quorum")
+	}
+	if err := validateCategoryThresholds(p.CategoryThresholds); err != nil {
+		return errors.Wrap(err, "invalid category_thresholds")
+	}
+	if err := validateVetoShare(p.VetoShare); err != nil {
+		return errors.Wrap(err, "invalid veto_share")
+	}
+	if err := validateVotingPeriod(p.RevealPeriod); err != nil {
+		return errors.Wrap(err, "invalid reveal_period")
+	}
+	if err := validateVotingPeriod(p.MinPollPeriod); err != nil {
+		return errors.Wrap(err, "invalid min_poll_period")
+	}
+	if err := validateVotingPeriod(p.MaxPollPeriod); err != nil {
+		return errors.Wrap(err, "invalid max_poll_period")
+	}
+	if p.MinPollPeriod > p.MaxPollPeriod {
+		return errors.New("min_poll_period must not exceed max_poll_period")
+	}
+	if err := validateProposalDeposit(p.ProposalDeposit); err != nil {
+		return errors.Wrap(err, "invalid proposal_deposit")
+	}
 	return nil
 }
 
+// ThresholdFor returns the strictest threshold among the specified proposal categories.
+func (p Params) ThresholdFor(categories ...ProposalCategory) util.Fraction {
+	var result util.Fraction
+	for _, category := range categories {
+		threshold := p.Threshold
+		for _, ct := range p.CategoryThresholds {
+			if ct.Category == category {
+				threshold = ct.Threshold
+				break
+			}
+		}
+		if result.IsNullValue() || threshold.GT(result) {
+			result = threshold
+		}
+	}
+	if result.IsNullValue() {
+		return p.Threshold
+	}
+	return result
+}
+
+// Decide tells whether a proposal's outcome is settled given its votes. Unless it's the final count (i.e. the voting
+// period is over), the outcome is settled only if no remaining votes can change it. Abstentions count toward the
+// quorum, but not toward the threshold, and vetoes count as "no" besides being checked against VetoShare.
+func (p Params) Decide(categories []ProposalCategory, total int, tally Tally, final bool) (complete bool, agree bool) {
+	var (
+		threshold = p.ThresholdFor(categories...)
+		remaining = int64(total - tally.Voted())
+		yes       = util.FractionInt(int64(tally.Yes))
+		counted   = int64(tally.Counted())
+		quorumMet = util.FractionInt(int64(tally.Voted())).GTE(p.Quorum.MulInt64(int64(total)))
+	)
+	if final {
+		return true, quorumMet && counted != 0 && !p.IsVetoed(tally) && yes.GTE(threshold.MulInt64(counted))
+	}
+	if util.FractionInt(int64(tally.Veto)).GT(p.VetoShare.MulInt64(int64(total))) {
+		// vetoed even if everyone else votes
+		return true, false
+	}
+	if remaining == 0 {
+		return p.Decide(categories, total, tally, true)
+	}
+	// The worst case is when all the remaining governors veto
+	if quorumMet &&
+		yes.GTE(threshold.MulInt64(counted+remaining)) &&
+		util.FractionInt(int64(tally.Veto)+remaining).LTE(p.VetoShare.MulInt64(int64(total))) {
+		return true, true
+	}
+	// The best case is when all the remaining governors agree
+	if util.FractionInt(int64(tally.Yes) + remaining).LT(threshold.MulInt64(counted + remaining)) {
+		return true, false
+	}
+	return false, false
+}
+
+// IsVetoed reports whether the share of vetoes among votes cast exceeds VetoShare.
+func (p Params) IsVetoed(tally Tally) bool {
+	return tally.Veto != 0 && util.FractionInt(int64(tally.Veto)).GT(p.VetoShare.MulInt64(int64(tally.Voted())))
+}
+
 func validateVotingPeriod(i interface{}) error {
 	v, ok := i.(int32)
 	if !ok {
@@ -81,3 +204,61 @@ func validateVotingPeriod(i interface{}) error {
 
 	return nil
 }
+
+func validateProposalDeposit(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("proposal deposit must be non-negative: %d", v)
+	}
+
+	return nil
+}
+
+func validateShare(i interface{}) error {
+	v, ok := i.(util.Fraction)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNullValue() || !v.IsPositive() {
+		return fmt.Errorf("must be positive: %s", v)
+	}
+	if v.GT(util.FractionInt(1)) {
+		return fmt.Errorf("must not exceed 100%%: %s", v)
+	}
+
+	return nil
+}
+
+func validateThreshold(i interface{}) error { return validateShare(i) }
+
+func validateQuorum(i interface{}) error { return validateShare(i) }
+
+func validateVetoShare(i interface{}) error { return validateShare(i) }
+
+func validateCategoryThresholds(i interface{}) error {
+	v, ok := i.([]CategoryThreshold)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[ProposalCategory]bool, len(v))
+	for _, ct := range v {
+		if _, ok := ProposalCategory_name[int32(ct.Category)]; !ok {
+			return fmt.Errorf("unknown category: %d", ct.Category)
+		}
+		if seen[ct.Category] {
+			return fmt.Errorf("duplicate category: %s", ct.Category)
+		}
+		seen[ct.Category] = true
+		if err := validateShare(ct.Threshold); err != nil {
+			return errors.Wrapf(err, "invalid threshold for %s", ct.Category)
+		}
+	}
+
+	return nil
+}
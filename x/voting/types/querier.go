@@ -4,7 +4,7 @@ package types
 const (
 	QueryParams     = "params"
 	QueryGovernment = "government"
-	QueryCurrent    = "current"
+	QueryActive     = "active"
 	QueryStatus     = "status"
 	QueryHistory    = "history"
 )
@@ -1,13 +1,24 @@
 package types
 
 import (
+	"crypto/sha256"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
 	"github.com/pkg/errors"
 	"gopkg.in/yaml.v3"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/arterynetwork/artr/util"
+	bank "github.com/arterynetwork/artr/x/bank/types"
 	"github.com/arterynetwork/artr/x/delegating"
+	delegatingTypes "github.com/arterynetwork/artr/x/delegating/types"
+	earning "github.com/arterynetwork/artr/x/earning/types"
+	noding "github.com/arterynetwork/artr/x/noding/types"
+	profile "github.com/arterynetwork/artr/x/profile/types"
 	referral "github.com/arterynetwork/artr/x/referral/types"
 )
 
@@ -34,6 +45,11 @@ func (p Proposal) Validate() error {
 	if _, err := sdk.AccAddressFromBech32(p.Author); err != nil {
 		return errors.Wrap(err, "invalid author")
 	}
+	return p.ValidateArgs()
+}
+
+// ValidateArgs checks if the proposal args match its type.
+func (p Proposal) ValidateArgs() error {
 	switch p.Type {
 	case
 		PROPOSAL_TYPE_CANCEL_SOFTWARE_UPGRADE,
@@ -88,7 +104,6 @@ func (p Proposal) Validate() error {
 		PROPOSAL_TYPE_GOVERNMENT_REMOVE,
 		PROPOSAL_TYPE_FREE_CREATOR_ADD,
 		PROPOSAL_TYPE_FREE_CREATOR_REMOVE,
-		PROPOSAL_TYPE_STAFF_VALIDATOR_ADD,
 		PROPOSAL_TYPE_STAFF_VALIDATOR_REMOVE,
 		PROPOSAL_TYPE_EARNING_SIGNER_ADD,
 		PROPOSAL_TYPE_EARNING_SIGNER_REMOVE,
@@ -111,6 +126,21 @@ func (p Proposal) Validate() error {
 				return errors.Wrap(err, "invalid args")
 			}
 		}
+	case PROPOSAL_TYPE_STAFF_VALIDATOR_ADD:
+		switch args := p.Args.(type) {
+		case nil:
+			return errors.New("invalid args: nil, *Proposal_StaffValidator expected")
+		case *Proposal_StaffValidator:
+			if err := args.StaffValidator.Validate(); err != nil {
+				return errors.Wrap(err, "invalid args")
+			}
+		case *Proposal_Address:
+			if err := args.Address.Validate(); err != nil {
+				return errors.Wrap(err, "invalid args")
+			}
+		default:
+			return errors.Errorf("invalid args: %T, *Proposal_StaffValidator expected", p.Args)
+		}
 	case PROPOSAL_TYPE_SOFTWARE_UPGRADE:
 		if p.Args == nil {
 			return errors.New("invalid args: nil, *Proposal_SoftwareUpgrade expected")
@@ -126,7 +156,9 @@ func (p Proposal) Validate() error {
 		PROPOSAL_TYPE_MIN_SEND,
 		PROPOSAL_TYPE_MIN_DELEGATE,
 		PROPOSAL_TYPE_DUST_DELEGATION,
-		PROPOSAL_TYPE_MAX_TRANSACTION_FEE:
+		PROPOSAL_TYPE_MAX_TRANSACTION_FEE,
+		PROPOSAL_TYPE_RENAME_PRICE,
+		PROPOSAL_TYPE_PROPOSAL_DEPOSIT:
 
 		if p.Args == nil {
 			return errors.New("invalid args: nil, *Proposal_MinAmount expected")
@@ -137,6 +169,9 @@ func (p Proposal) Validate() error {
 			if err := args.MinAmount.Validate(); err != nil {
 				return errors.Wrap(err, "invalid args")
 			}
+			if (p.Type == PROPOSAL_TYPE_RENAME_PRICE || p.Type == PROPOSAL_TYPE_PROPOSAL_DEPOSIT) && args.MinAmount.MinAmount < 0 {
+				return errors.New("non-negative number expected")
+			}
 		}
 	case
 		PROPOSAL_TYPE_MAX_VALIDATORS,
@@ -153,7 +188,8 @@ func (p Proposal) Validate() error {
 			}
 		}
 	case
-		PROPOSAL_TYPE_JAIL_AFTER:
+		PROPOSAL_TYPE_JAIL_AFTER,
+		PROPOSAL_TYPE_UNJAIL_AFTER:
 
 		if p.Args == nil {
 			return errors.New("invalid args: nil, *Proposal_Count expected")
@@ -168,6 +204,23 @@ func (p Proposal) Validate() error {
 				return errors.New("positive number expected")
 			}
 		}
+	case
+		PROPOSAL_TYPE_BASE_VPN_GB,
+		PROPOSAL_TYPE_BASE_STORAGE_GB:
+
+		if p.Args == nil {
+			return errors.New("invalid args: nil, *Proposal_Count expected")
+		}
+		if args, ok := p.Args.(*Proposal_Count); !ok {
+			return errors.Errorf("invalid args: %T, *Proposal_Count expected", p.Args)
+		} else {
+			if err := args.Count.Validate(); err != nil {
+				return errors.Wrap(err, "invalid args")
+			}
+			if args.Count.Count == 0 {
+				return errors.New("number of gigabytes must be positive")
+			}
+		}
 	case PROPOSAL_TYPE_VALIDATOR_MINIMAL_STATUS:
 		if p.Args == nil {
 			return errors.New("invalid args: nil, *Proposal_Status expected")
@@ -284,12 +337,357 @@ func (p Proposal) Validate() error {
 				return errors.Wrap(err, "invalid args")
 			}
 		}
+	case
+		PROPOSAL_TYPE_VOTING_PERIOD,
+		PROPOSAL_TYPE_POLL_PERIOD:
+
+		if p.Args == nil {
+			return errors.New("invalid args: nil, *Proposal_Count expected")
+		}
+		if args, ok := p.Args.(*Proposal_Count); !ok {
+			return errors.Errorf("invalid args: %T, *Proposal_Count expected", p.Args)
+		} else {
+			if err := args.Count.Validate(); err != nil {
+				return errors.Wrap(err, "invalid args")
+			}
+			if args.Count.Count <= 0 || args.Count.Count > math.MaxInt32 {
+				return errors.Errorf("number of hours out of range: %d", args.Count.Count)
+			}
+		}
+	case PROPOSAL_TYPE_COMPANY_ACCOUNTS:
+		if p.Args == nil {
+			return errors.New("invalid args: nil, *Proposal_CompanyAccounts expected")
+		}
+		if args, ok := p.Args.(*Proposal_CompanyAccounts); !ok {
+			return errors.Errorf("invalid args: %T, *Proposal_CompanyAccounts expected", p.Args)
+		} else {
+			if err := args.CompanyAccounts.Validate(); err != nil {
+				return errors.Wrap(err, "invalid args")
+			}
+		}
+	case PROPOSAL_TYPE_COMPANY_ACCOUNT:
+		if p.Args == nil {
+			return errors.New("invalid args: nil, *Proposal_Address expected")
+		}
+		if args, ok := p.Args.(*Proposal_Address); !ok {
+			return errors.Errorf("invalid args: %T, *Proposal_Address expected", p.Args)
+		} else {
+			if err := args.Address.Validate(); err != nil {
+				return errors.Wrap(err, "invalid args")
+			}
+		}
+	case PROPOSAL_TYPE_PARAM_CHANGE:
+		if p.Args == nil {
+			return errors.New("invalid args: nil, *Proposal_ParamChange expected")
+		}
+		if args, ok := p.Args.(*Proposal_ParamChange); !ok {
+			return errors.Errorf("invalid args: %T, *Proposal_ParamChange expected", p.Args)
+		} else {
+			if err := args.ParamChange.Validate(); err != nil {
+				return errors.Wrap(err, "invalid args")
+			}
+		}
+	case PROPOSAL_TYPE_MULTI_CHANGE:
+		if p.Args == nil {
+			return errors.New("invalid args: nil, *Proposal_MultiChange expected")
+		}
+		if args, ok := p.Args.(*Proposal_MultiChange); !ok {
+			return errors.Errorf("invalid args: %T, *Proposal_MultiChange expected", p.Args)
+		} else {
+			if err := args.MultiChange.Validate(); err != nil {
+				return errors.Wrap(err, "invalid args")
+			}
+		}
 	default:
 		return errors.Errorf("invalid type: %s", p.Type)
 	}
 	return nil
 }
 
+// proposalTargets maps proposal types to what they change. Proposals with the same target cannot be voted at the same
+// time. Parameters are named by their subspaces and store keys, so that typed proposals conflict with param-change
+// ones. Immutable parameters (see immutableParams) have no typed proposals, so they aren't listed.
+var proposalTargets = map[ProposalType]string{
+	PROPOSAL_TYPE_ENTER_PRICE:                  paramTarget(profile.ModuleName, profile.KeySubscriptionPrice),
+	PROPOSAL_TYPE_GOVERNMENT_ADD:               "voting/government",
+	PROPOSAL_TYPE_GOVERNMENT_REMOVE:            "voting/government",
+	PROPOSAL_TYPE_PRODUCT_VPN_BASE_PRICE:       paramTarget(profile.ModuleName, profile.KeyVpnGbPrice),
+	PROPOSAL_TYPE_PRODUCT_STORAGE_BASE_PRICE:   paramTarget(profile.ModuleName, profile.KeyStorageGbPrice),
+	PROPOSAL_TYPE_FREE_CREATOR_ADD:             paramTarget(profile.ModuleName, profile.KeyCreators),
+	PROPOSAL_TYPE_FREE_CREATOR_REMOVE:          paramTarget(profile.ModuleName, profile.KeyCreators),
+	PROPOSAL_TYPE_SOFTWARE_UPGRADE:             "upgrade/plan",
+	PROPOSAL_TYPE_CANCEL_SOFTWARE_UPGRADE:      "upgrade/plan",
+	PROPOSAL_TYPE_STAFF_VALIDATOR_ADD:          "noding/staff",
+	PROPOSAL_TYPE_STAFF_VALIDATOR_REMOVE:       "noding/staff",
+	PROPOSAL_TYPE_EARNING_SIGNER_ADD:           paramTarget(earning.ModuleName, earning.KeySigners),
+	PROPOSAL_TYPE_EARNING_SIGNER_REMOVE:        paramTarget(earning.ModuleName, earning.KeySigners),
+	PROPOSAL_TYPE_TOKEN_RATE_SIGNER_ADD:        paramTarget(profile.ModuleName, profile.KeyTokenRateSigners),
+	PROPOSAL_TYPE_TOKEN_RATE_SIGNER_REMOVE:     paramTarget(profile.ModuleName, profile.KeyTokenRateSigners),
+	PROPOSAL_TYPE_VPN_SIGNER_ADD:               paramTarget(profile.ModuleName, profile.KeyVpnSigners),
+	PROPOSAL_TYPE_VPN_SIGNER_REMOVE:            paramTarget(profile.ModuleName, profile.KeyVpnSigners),
+	PROPOSAL_TYPE_STORAGE_SIGNER_ADD:           paramTarget(profile.ModuleName, profile.KeyStorageSigners),
+	PROPOSAL_TYPE_STORAGE_SIGNER_REMOVE:        paramTarget(profile.ModuleName, profile.KeyStorageSigners),
+	PROPOSAL_TYPE_TRANSITION_PRICE:             paramTarget(referral.ModuleName, referral.KeyTransitionCost),
+	PROPOSAL_TYPE_MIN_SEND:                     paramTarget(bank.ModuleName, bank.ParamStoreKeyMinSend),
+	PROPOSAL_TYPE_MIN_DELEGATE:                 paramTarget(delegatingTypes.ModuleName, delegatingTypes.KeyMinDelegate),
+	PROPOSAL_TYPE_MAX_VALIDATORS:               paramTarget(noding.ModuleName, noding.KeyMaxValidators),
+	PROPOSAL_TYPE_LUCKY_VALIDATORS:             paramTarget(noding.ModuleName, noding.KeyLotteryValidators),
+	PROPOSAL_TYPE_GENERAL_AMNESTY:              "noding/amnesty",
+	PROPOSAL_TYPE_VALIDATOR_MINIMAL_CRITERIA:   paramTarget(noding.ModuleName, noding.KeyMinCriteria),
+	PROPOSAL_TYPE_JAIL_AFTER:                   paramTarget(noding.ModuleName, noding.KeyJailAfter),
+	PROPOSAL_TYPE_DUST_DELEGATION:              paramTarget(bank.ModuleName, bank.ParamStoreKeyDustDelegation),
+	PROPOSAL_TYPE_VOTING_POWER:                 paramTarget(noding.ModuleName, noding.KeyVotingPower),
+	PROPOSAL_TYPE_TRANSACTION_FEE:              paramTarget(bank.ModuleName, bank.ParamStoreKeyTransactionFee),
+	PROPOSAL_TYPE_MAX_TRANSACTION_FEE:          paramTarget(bank.ModuleName, bank.ParamStoreKeyMaxTransactionFee),
+	PROPOSAL_TYPE_TRANSACTION_FEE_SPLIT_RATIOS: paramTarget(bank.ModuleName, bank.ParamStoreKeyTransactionFeeSplitRatios),
+	PROPOSAL_TYPE_ACCRUE_PERCENTAGE_TABLE:      paramTarget(delegatingTypes.ModuleName, delegatingTypes.KeyAccruePercentageTable),
+	PROPOSAL_TYPE_BLOCKED_SENDER_ADD:           paramTarget(bank.ModuleName, bank.ParamStoreKeyBlockedSenders),
+	PROPOSAL_TYPE_BLOCKED_SENDER_REMOVE:        paramTarget(bank.ModuleName, bank.ParamStoreKeyBlockedSenders),
+	PROPOSAL_TYPE_REVOKE:                       paramTarget(delegatingTypes.ModuleName, delegatingTypes.KeyRevoke),
+	PROPOSAL_TYPE_EXPRESS_REVOKE:               paramTarget(delegatingTypes.ModuleName, delegatingTypes.KeyExpressRevoke),
+	PROPOSAL_TYPE_UNJAIL_AFTER:                 paramTarget(noding.ModuleName, noding.KeyUnjailAfter),
+	PROPOSAL_TYPE_RENAME_PRICE:                 paramTarget(profile.ModuleName, profile.KeyFee),
+	PROPOSAL_TYPE_BASE_VPN_GB:                  paramTarget(profile.ModuleName, profile.KeyBaseVpnGb),
+	PROPOSAL_TYPE_BASE_STORAGE_GB:              paramTarget(profile.ModuleName, profile.KeyBaseStorageGb),
+	PROPOSAL_TYPE_VOTING_PERIOD:                paramTarget(ModuleName, KeyParamVotingPeriod),
+	PROPOSAL_TYPE_POLL_PERIOD:                  paramTarget(ModuleName, KeyParamPollPeriod),
+	PROPOSAL_TYPE_COMPANY_ACCOUNTS:             paramTarget(referral.ModuleName, referral.KeyCompanyAccounts),
+	PROPOSAL_TYPE_COMPANY_ACCOUNT:              paramTarget(bank.ModuleName, bank.ParamStoreKeyCompanyAccount),
+	PROPOSAL_TYPE_PROPOSAL_DEPOSIT:             paramTarget(ModuleName, KeyParamProposalDeposit),
+}
+
+func paramTarget(subspace string, key []byte) string {
+	return ParamChange{Subspace: subspace, Key: string(key)}.Target()
+}
+
+// Targets returns a list of parameters (or list items) the proposal is going to change.
+func (p Proposal) Targets() []string {
+	target, ok := proposalTargets[p.Type]
+	if !ok {
+		target = p.Type.String()
+	}
+	switch args := p.Args.(type) {
+	case *Proposal_Address:
+		target += "/" + args.Address.Address
+	case *Proposal_StaffValidator:
+		target += "/" + args.StaffValidator.Address
+	case *Proposal_MultiChange:
+		var targets []string
+		for _, change := range args.MultiChange.Changes {
+			targets = append(targets, change.Targets()...)
+		}
+		return targets
+	case *Proposal_ParamChange:
+		targets := make([]string, len(args.ParamChange.Changes))
+		for i, change := range args.ParamChange.Changes {
+			targets[i] = change.Target()
+		}
+		return targets
+	}
+	return []string{target}
+}
+
+// proposalCategories maps proposal types to their categories. Types not listed here are of the general category.
+var proposalCategories = map[ProposalType]ProposalCategory{
+	PROPOSAL_TYPE_ENTER_PRICE:                  PROPOSAL_CATEGORY_PRICE,
+	PROPOSAL_TYPE_PRODUCT_VPN_BASE_PRICE:       PROPOSAL_CATEGORY_PRICE,
+	PROPOSAL_TYPE_PRODUCT_STORAGE_BASE_PRICE:   PROPOSAL_CATEGORY_PRICE,
+	PROPOSAL_TYPE_TRANSITION_PRICE:             PROPOSAL_CATEGORY_PRICE,
+	PROPOSAL_TYPE_RENAME_PRICE:                 PROPOSAL_CATEGORY_PRICE,
+	PROPOSAL_TYPE_BASE_VPN_GB:                  PROPOSAL_CATEGORY_PRICE,
+	PROPOSAL_TYPE_BASE_STORAGE_GB:              PROPOSAL_CATEGORY_PRICE,
+	PROPOSAL_TYPE_GOVERNMENT_ADD:               PROPOSAL_CATEGORY_GOVERNMENT,
+	PROPOSAL_TYPE_GOVERNMENT_REMOVE:            PROPOSAL_CATEGORY_GOVERNMENT,
+	PROPOSAL_TYPE_SOFTWARE_UPGRADE:             PROPOSAL_CATEGORY_UPGRADE,
+	PROPOSAL_TYPE_CANCEL_SOFTWARE_UPGRADE:      PROPOSAL_CATEGORY_UPGRADE,
+	PROPOSAL_TYPE_FREE_CREATOR_ADD:             PROPOSAL_CATEGORY_PERMISSION,
+	PROPOSAL_TYPE_FREE_CREATOR_REMOVE:          PROPOSAL_CATEGORY_PERMISSION,
+	PROPOSAL_TYPE_STAFF_VALIDATOR_ADD:          PROPOSAL_CATEGORY_PERMISSION,
+	PROPOSAL_TYPE_STAFF_VALIDATOR_REMOVE:       PROPOSAL_CATEGORY_PERMISSION,
+	PROPOSAL_TYPE_EARNING_SIGNER_ADD:           PROPOSAL_CATEGORY_PERMISSION,
+	PROPOSAL_TYPE_EARNING_SIGNER_REMOVE:        PROPOSAL_CATEGORY_PERMISSION,
+	PROPOSAL_TYPE_TOKEN_RATE_SIGNER_ADD:        PROPOSAL_CATEGORY_PERMISSION,
+	PROPOSAL_TYPE_TOKEN_RATE_SIGNER_REMOVE:     PROPOSAL_CATEGORY_PERMISSION,
+	PROPOSAL_TYPE_VPN_SIGNER_ADD:               PROPOSAL_CATEGORY_PERMISSION,
+	PROPOSAL_TYPE_VPN_SIGNER_REMOVE:            PROPOSAL_CATEGORY_PERMISSION,
+	PROPOSAL_TYPE_STORAGE_SIGNER_ADD:           PROPOSAL_CATEGORY_PERMISSION,
+	PROPOSAL_TYPE_STORAGE_SIGNER_REMOVE:        PROPOSAL_CATEGORY_PERMISSION,
+	PROPOSAL_TYPE_BLOCKED_SENDER_ADD:           PROPOSAL_CATEGORY_PERMISSION,
+	PROPOSAL_TYPE_BLOCKED_SENDER_REMOVE:        PROPOSAL_CATEGORY_PERMISSION,
+	PROPOSAL_TYPE_MIN_SEND:                     PROPOSAL_CATEGORY_ECONOMY,
+	PROPOSAL_TYPE_MIN_DELEGATE:                 PROPOSAL_CATEGORY_ECONOMY,
+	PROPOSAL_TYPE_DUST_DELEGATION:              PROPOSAL_CATEGORY_ECONOMY,
+	PROPOSAL_TYPE_TRANSACTION_FEE:              PROPOSAL_CATEGORY_ECONOMY,
+	PROPOSAL_TYPE_MAX_TRANSACTION_FEE:          PROPOSAL_CATEGORY_ECONOMY,
+	PROPOSAL_TYPE_TRANSACTION_FEE_SPLIT_RATIOS: PROPOSAL_CATEGORY_ECONOMY,
+	PROPOSAL_TYPE_ACCRUE_PERCENTAGE_TABLE:      PROPOSAL_CATEGORY_ECONOMY,
+	PROPOSAL_TYPE_REVOKE:                       PROPOSAL_CATEGORY_ECONOMY,
+	PROPOSAL_TYPE_EXPRESS_REVOKE:               PROPOSAL_CATEGORY_ECONOMY,
+	PROPOSAL_TYPE_COMPANY_ACCOUNTS:             PROPOSAL_CATEGORY_ECONOMY,
+	PROPOSAL_TYPE_COMPANY_ACCOUNT:              PROPOSAL_CATEGORY_ECONOMY,
+	PROPOSAL_TYPE_MAX_VALIDATORS:               PROPOSAL_CATEGORY_NODING,
+	PROPOSAL_TYPE_LUCKY_VALIDATORS:             PROPOSAL_CATEGORY_NODING,
+	PROPOSAL_TYPE_GENERAL_AMNESTY:              PROPOSAL_CATEGORY_NODING,
+	PROPOSAL_TYPE_VALIDATOR_MINIMAL_CRITERIA:   PROPOSAL_CATEGORY_NODING,
+	PROPOSAL_TYPE_JAIL_AFTER:                   PROPOSAL_CATEGORY_NODING,
+	PROPOSAL_TYPE_VOTING_POWER:                 PROPOSAL_CATEGORY_NODING,
+	PROPOSAL_TYPE_UNJAIL_AFTER:                 PROPOSAL_CATEGORY_NODING,
+}
+
+// targetCategories maps typed proposals' targets to their categories, so that a param-change proposal is decided the
+// same way as a typed one changing the same parameter would be.
+var targetCategories = func() map[string]ProposalCategory {
+	result := make(map[string]ProposalCategory, len(proposalTargets))
+	for typ, target := range proposalTargets {
+		result[target] = proposalCategories[typ]
+	}
+	return result
+}()
+
+// Categories returns the categories of changes the proposal makes (several ones for a multi-change proposal).
+func (p Proposal) Categories() []ProposalCategory {
+	if args, ok := p.Args.(*Proposal_MultiChange); ok {
+		var categories []ProposalCategory
+		for _, change := range args.MultiChange.Changes {
+			categories = append(categories, change.Categories()...)
+		}
+		return categories
+	}
+	if args, ok := p.Args.(*Proposal_ParamChange); ok {
+		categories := make([]ProposalCategory, len(args.ParamChange.Changes))
+		for i, change := range args.ParamChange.Changes {
+			categories[i] = change.Category()
+		}
+		return categories
+	}
+	return []ProposalCategory{proposalCategories[p.Type]}
+}
+
+// ConflictsWith reports whether two proposals change the same parameter (or a list and its item).
+func (p Proposal) ConflictsWith(other Proposal) bool {
+	for _, x := range p.Targets() {
+		for _, y := range other.Targets() {
+			if x == y || strings.HasPrefix(x, y+"/") || strings.HasPrefix(y, x+"/") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (args MultiChangeArgs) Validate() error {
+	if len(args.Changes) == 0 {
+		return errors.New("no changes")
+	}
+	for i, change := range args.Changes {
+		if change.Type == PROPOSAL_TYPE_MULTI_CHANGE {
+			return errors.Errorf("invalid change #%d: nested multi-change", i)
+		}
+		if change.ActivateAt != nil {
+			return errors.Errorf("invalid change #%d: activation time is set for the whole proposal only", i)
+		}
+		if err := change.ValidateArgs(); err != nil {
+			return errors.Wrapf(err, "invalid change #%d", i)
+		}
+		for j := 0; j < i; j++ {
+			if change.ConflictsWith(args.Changes[j]) {
+				return errors.Errorf("invalid change #%d: changes the same parameter as #%d", i, j)
+			}
+		}
+	}
+	return nil
+}
+
+func (ap ActiveProposal) GetAgreed() Government { return Government{Members: ap.Agreed} }
+
+func (ap ActiveProposal) GetDisagreed() Government { return Government{Members: ap.Disagreed} }
+
+func (ap ActiveProposal) GetAbstained() Government { return Government{Members: ap.Abstained} }
+
+func (ap ActiveProposal) GetVetoed() Government { return Government{Members: ap.Vetoed} }
+
+// Voters returns a list of governors who have voted with the specified option.
+func (ap *ActiveProposal) Voters(option VoteOption) *[]string {
+	switch option {
+	case VOTE_OPTION_YES:
+		return &ap.Agreed
+	case VOTE_OPTION_NO:
+		return &ap.Disagreed
+	case VOTE_OPTION_ABSTAIN:
+		return &ap.Abstained
+	case VOTE_OPTION_VETO:
+		return &ap.Vetoed
+	default:
+		return nil
+	}
+}
+
+// VoteOf returns an option the governor has voted with (or VOTE_OPTION_UNSPECIFIED if they haven't voted yet).
+func (ap ActiveProposal) VoteOf(voter sdk.AccAddress) VoteOption {
+	for _, option := range []VoteOption{VOTE_OPTION_YES, VOTE_OPTION_NO, VOTE_OPTION_ABSTAIN, VOTE_OPTION_VETO} {
+		if (Government{Members: *ap.Voters(option)}).Contains(voter) {
+			return option
+		}
+	}
+	return VOTE_OPTION_UNSPECIFIED
+}
+
+func (ap ActiveProposal) Tally() Tally {
+	return Tally{
+		Yes:     len(ap.Agreed),
+		No:      len(ap.Disagreed),
+		Abstain: len(ap.Abstained),
+		Veto:    len(ap.Vetoed),
+	}
+}
+
+// Tally is a number of votes cast with each option.
+type Tally struct {
+	Yes, No, Abstain, Veto int
+}
+
+// Voted returns a total number of votes cast.
+func (t Tally) Voted() int { return t.Yes + t.No + t.Abstain + t.Veto }
+
+// Counted returns a number of votes counted toward the threshold, i.e. all but abstentions.
+func (t Tally) Counted() int { return t.Yes + t.No + t.Veto }
+
+func (ap ActiveProposal) Validate() error {
+	if err := ap.Proposal.Validate(); err != nil {
+		return errors.Wrap(err, "invalid proposal")
+	}
+	if ap.Proposal.Id == 0 {
+		return errors.New("invalid proposal: id must be positive")
+	}
+	if ap.Proposal.EndTime == nil {
+		return errors.New("invalid proposal: end_time must be set")
+	}
+	for i, bech32 := range ap.Agreed {
+		if _, err := sdk.AccAddressFromBech32(bech32); err != nil {
+			return errors.Wrapf(err, "invalid agreed (item #%d)", i)
+		}
+	}
+	for i, bech32 := range ap.Disagreed {
+		if _, err := sdk.AccAddressFromBech32(bech32); err != nil {
+			return errors.Wrapf(err, "invalid disagreed (item #%d)", i)
+		}
+	}
+	for i, bech32 := range ap.Abstained {
+		if _, err := sdk.AccAddressFromBech32(bech32); err != nil {
+			return errors.Wrapf(err, "invalid abstained (item #%d)", i)
+		}
+	}
+	for i, bech32 := range ap.Vetoed {
+		if _, err := sdk.AccAddressFromBech32(bech32); err != nil {
+			return errors.Wrapf(err, "invalid vetoed (item #%d)", i)
+		}
+	}
+	if ap.Started <= 0 {
+		return errors.New("invalid started: must be positive")
+	}
+	return nil
+}
+
 func (g Government) GetMembers() []sdk.AccAddress {
 	addrz := make([]sdk.AccAddress, len(g.Members))
 	for i, bech32 := range g.Members {
@@ -379,6 +777,16 @@ func (r ProposalHistoryRecord) Validate() error {
 			return errors.Wrapf(err, "invalid disagreed (item #%d)", i)
 		}
 	}
+	for i, bech32 := range r.Abstained {
+		if _, err := sdk.AccAddressFromBech32(bech32); err != nil {
+			return errors.Wrapf(err, "invalid abstained (item #%d)", i)
+		}
+	}
+	for i, bech32 := range r.Vetoed {
+		if _, err := sdk.AccAddressFromBech32(bech32); err != nil {
+			return errors.Wrapf(err, "invalid vetoed (item #%d)", i)
+		}
+	}
 	if r.Started <= 0 {
 		return errors.New("invalid started: must be positive")
 	}
@@ -388,6 +796,41 @@ func (r ProposalHistoryRecord) Validate() error {
 	return nil
 }
 
+func (pc PendingChange) Validate() error {
+	if err := pc.Proposal.Validate(); err != nil {
+		return errors.Wrap(err, "invalid proposal")
+	}
+	if pc.Proposal.Id == 0 {
+		return errors.New("invalid proposal: id must be positive")
+	}
+	if pc.Proposal.ActivateAt == nil {
+		return errors.New("invalid proposal: activate_at must be set")
+	}
+	if pc.Finished <= 0 {
+		return errors.New("invalid finished: must be positive")
+	}
+	return nil
+}
+
+// MaxPollOptions is the maximal number of options a multi-choice poll can offer.
+const MaxPollOptions = 10
+
+// PollCommitmentHash returns a hash a respondent commits to a secret poll: SHA-256 of "<respondent>:<answer>:<salt>",
+// where the answer is "yes", "no" or a 1-based option number for a multi-choice poll.
+func PollCommitmentHash(respondent string, yes bool, option uint32, salt string) []byte {
+	var answer string
+	switch {
+	case option != 0:
+		answer = strconv.FormatUint(uint64(option), 10)
+	case yes:
+		answer = "yes"
+	default:
+		answer = "no"
+	}
+	hash := sha256.Sum256([]byte(respondent + ":" + answer + ":" + salt))
+	return hash[:]
+}
+
 func NewPollValidators(author sdk.AccAddress, name, text string, quorum util.Fraction) Poll {
 	return Poll{
 		Name:         name,
@@ -437,7 +880,48 @@ func (p Poll) Validate() error {
 	if p.StartTime != nil && p.EndTime != nil && !p.EndTime.After(*p.StartTime) {
 		return errors.New("start_time after end_time")
 	}
+	if p.RevealEndTime != nil && (!p.Secret || p.EndTime == nil || !p.RevealEndTime.After(*p.EndTime)) {
+		return errors.New("reveal_end_time must be after end_time and for a secret poll only")
+	}
+	if p.Period < 0 {
+		return errors.New("period must not be negative")
+	}
+	for i, bech32 := range p.CancelVotes {
+		if _, err := sdk.AccAddressFromBech32(bech32); err != nil {
+			return errors.Wrapf(err, "invalid cancel_votes (item #%d)", i)
+		}
+	}
+	if len(p.Options) != 0 {
+		if len(p.Options) < 2 || len(p.Options) > MaxPollOptions {
+			return errors.Errorf("a multi-choice poll must have from 2 to %d options", MaxPollOptions)
+		}
+		if p.Quorum != nil {
+			return errors.New("a multi-choice poll cannot have a quorum")
+		}
+		for i, opt := range p.Options {
+			if len(opt) == 0 {
+				return errors.Errorf("option #%d is empty", i+1)
+			}
+			for j := 0; j < i; j++ {
+				if opt == p.Options[j] {
+					return errors.Errorf("option #%d duplicates #%d", i+1, j+1)
+				}
+			}
+		}
+	}
 	return nil
 }
 
+// IsMultiChoice reports whether the poll offers custom options instead of a plain yes/no.
+func (p Poll) IsMultiChoice() bool { return len(p.Options) != 0 }
+
+// FinishTime returns when the poll answers are counted, i.e. its reveal end time if it's a secret one and its end time
+// otherwise.
+func (p Poll) FinishTime() time.Time {
+	if p.Secret {
+		return *p.RevealEndTime
+	}
+	return *p.EndTime
+}
+
 func (u *Poll_Unit) Equal(other *Poll_Unit) bool { return (u == nil) == (other == nil) }